@@ -33,6 +33,10 @@ const (
 	SchemaTypeProtobuf SchemaType = "PROTOBUF"
 )
 
+// ResyncAnnotation requests an immediate reconcile of a Schema when its value changes.
+// kubectl-schema resync sets it to the current time.
+const ResyncAnnotation = "registry.strimzi.io/resync"
+
 // SchemaReference represents a reference to another schema
 type SchemaReference struct {
 	// Name of the referenced schema subject
@@ -59,6 +63,20 @@ type SchemaRegistryRef struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// SchemaVersion is one entry of spec.versions.
+type SchemaVersion struct {
+	// Schema is the schema definition of this version
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Schema string `json:"schema"`
+
+	// Version pins the version number the registry must report for this entry.
+	// A mismatch is reported as VersionMismatch and stops the later entries.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Version int `json:"version,omitempty"`
+}
+
 // SchemaSpec defines the desired state of Schema
 type SchemaSpec struct {
 	// Subject is the name under which the schema will be registered
@@ -71,24 +89,119 @@ type SchemaSpec struct {
 	// +kubebuilder:default=AVRO
 	SchemaType SchemaType `json:"schemaType"`
 
-	// Schema is the actual schema definition
-	// +required
+	// Schema is the actual schema definition.
+	// Exactly one of schema and versions must be set.
+	// +optional
 	// +kubebuilder:validation:MinLength=1
-	Schema string `json:"schema"`
+	Schema string `json:"schema,omitempty"`
+
+	// Versions lists the history of the subject, oldest first, instead of a single schema.
+	// The entries are registered in order, entries already in the subject are only looked
+	// up. The last entry is the current schema.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Versions []SchemaVersion `json:"versions,omitempty"`
 
 	// References to other schemas (for nested/imported schemas)
 	// +optional
 	References []SchemaReference `json:"references,omitempty"`
 
-	// RegistryRef references the Schema Registry endpoint configuration
-	// +required
-	RegistryRef SchemaRegistryRef `json:"registryRef"`
+	// RegistryRef references the Schema Registry endpoint configuration.
+	// Exactly one of registryRef and registryRefs must be set.
+	// +optional
+	RegistryRef SchemaRegistryRef `json:"registryRef,omitzero"`
+
+	// RegistryRefs registers the schema into several registries, e.g. a primary and a DR
+	// registry. The first registry is the primary one, its ID and version are reported in
+	// status.schemaId and status.version.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	RegistryRefs []SchemaRegistryRef `json:"registryRefs,omitempty"`
+
+	// SameSchemaID registers the schema into every registry after the first one under the
+	// schema ID assigned by the first, switching the subject to IMPORT mode for the
+	// registration. Only supported by registries with the Confluent API.
+	// +optional
+	SameSchemaID bool `json:"sameSchemaId,omitempty"`
+
+	// SchemaID registers the schema under this schema ID in every registry, switching the
+	// subject to IMPORT mode for the registration, so that rebuilt registries keep the IDs
+	// already written into Kafka messages. Reports IDConflict when the ID holds a different
+	// schema. Only supported by registries with the Confluent API.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	SchemaID int `json:"schemaId,omitempty"`
+
+	// Version registers the schema as this version of the subject. It requires schemaId
+	// and must be higher than the latest version of the subject; VersionConflict is
+	// reported when the version holds a different schema.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Version int `json:"version,omitempty"`
 
 	// CompatibilityLevel defines the compatibility checking mode
 	// Valid values: BACKWARD, BACKWARD_TRANSITIVE, FORWARD, FORWARD_TRANSITIVE, FULL, FULL_TRANSITIVE, NONE
 	// +optional
 	// +kubebuilder:validation:Enum=BACKWARD;BACKWARD_TRANSITIVE;FORWARD;FORWARD_TRANSITIVE;FULL;FULL_TRANSITIVE;NONE
 	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+
+	// Mode overrides the global registry mode for the subject. READWRITE unlocks the
+	// subject before the registration, READONLY and READONLY_OVERRIDE lock it after
+	// the schema is registered. The subject mode is left unmanaged when empty.
+	// Only supported by registries with the Confluent API.
+	// +optional
+	Mode RegistryMode `json:"mode,omitempty"`
+
+	// ObserveOnly adopts an existing subject without writing to the registry.
+	// The controller looks up the version of the subject that holds the schema and
+	// records its ID and version in the status, reporting NotFound or ContentMismatch
+	// when there is none. Compatibility is not set and the subject is kept on deletion.
+	// +optional
+	ObserveOnly bool `json:"observeOnly,omitempty"`
+
+	// Suspend stops all registry calls for this schema while true, including the
+	// subject cleanup on deletion. A schema deleted while suspended keeps its
+	// finalizer until it is resumed.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// SchemaVersionStatus is the state of one entry of spec.versions in the registry.
+type SchemaVersionStatus struct {
+	// Version is the version number of the entry in the subject
+	// +required
+	Version int `json:"version"`
+
+	// SchemaID is the ID of the entry
+	// +required
+	SchemaID int `json:"schemaId"`
+}
+
+// RegistrySchemaStatus is the state of the schema in one of the registries of spec.registryRefs.
+type RegistrySchemaStatus struct {
+	// Registry is the "<namespace>/<name>" of the SchemaRegistry
+	// +required
+	Registry string `json:"registry"`
+
+	// SchemaID is the ID assigned by this registry
+	// +optional
+	SchemaID *int `json:"schemaId,omitempty"`
+
+	// Version is the version number of the schema in this registry
+	// +optional
+	Version *int `json:"version,omitempty"`
+
+	// Ready is True when the schema is registered in this registry
+	// +required
+	Ready metav1.ConditionStatus `json:"ready"`
+
+	// Reason is the reason of the last registration attempt, e.g. Registered or IDMismatch
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message describes the last registration attempt
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // SchemaStatus defines the observed state of Schema.
@@ -105,6 +218,24 @@ type SchemaStatus struct {
 	// +optional
 	RegisteredAt *metav1.Time `json:"registeredAt,omitempty"`
 
+	// CompatibilityLevel is the subject compatibility level last applied to the registry
+	// +optional
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+
+	// Mode is the subject mode last applied to the registry from spec.mode
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Versions reports the entries of spec.versions, in the same order
+	// +optional
+	Versions []SchemaVersionStatus `json:"versions,omitempty"`
+
+	// Registries reports the schema in each registry of spec.registryRefs
+	// +listType=map
+	// +listMapKey=registry
+	// +optional
+	Registries []RegistrySchemaStatus `json:"registries,omitempty"`
+
 	// ObservedGeneration reflects the generation of the most recently observed Schema Spec
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -126,6 +257,14 @@ type SchemaStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Subject",type=string,JSONPath=`.spec.subject`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.schemaType`
+// +kubebuilder:printcolumn:name="ID",type=integer,JSONPath=`.status.schemaId`
+// +kubebuilder:printcolumn:name="Version",type=integer,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,priority=1
+// +kubebuilder:printcolumn:name="Registry",type=string,JSONPath=`.spec.registryRef.name`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Schema is the Schema for the schemas API
 type Schema struct {
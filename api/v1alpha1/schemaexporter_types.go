@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExporterContextType selects the schema context the exported subjects are placed in
+// +kubebuilder:validation:Enum=AUTO;CUSTOM;NONE;DEFAULT
+type ExporterContextType string
+
+const (
+	// ExporterContextAuto places the subjects in a context named after the source cluster
+	ExporterContextAuto ExporterContextType = "AUTO"
+	// ExporterContextCustom places the subjects in the context given by spec.context
+	ExporterContextCustom ExporterContextType = "CUSTOM"
+	// ExporterContextNone keeps the subjects in the default context of the destination
+	ExporterContextNone ExporterContextType = "NONE"
+	// ExporterContextDefault exports only the default context, keeping subject names unchanged
+	ExporterContextDefault ExporterContextType = "DEFAULT"
+)
+
+// SchemaExporterSpec defines the desired state of SchemaExporter
+type SchemaExporterSpec struct {
+	// RegistryRef references the SchemaRegistry running the exporter, which is the
+	// source of the exported subjects. Only registries with the Confluent API support exporters.
+	// +required
+	RegistryRef SchemaRegistryRef `json:"registryRef"`
+
+	// DestinationRef references the SchemaRegistry the subjects are exported to.
+	// Its URLs and BASIC or BEARER credentials become the exporter configuration.
+	// +required
+	DestinationRef SchemaRegistryRef `json:"destinationRef"`
+
+	// ExporterName is the name of the exporter in the registry. Defaults to metadata.name.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	ExporterName string `json:"exporterName,omitempty"`
+
+	// ContextType selects the schema context of the exported subjects in the destination
+	// +optional
+	// +kubebuilder:default=AUTO
+	ContextType ExporterContextType `json:"contextType,omitempty"`
+
+	// Context is the name of the destination context when contextType is CUSTOM
+	// +optional
+	Context string `json:"context,omitempty"`
+
+	// Subjects lists the exported subjects. An entry may be a subject name or "*"
+	// for every subject. Defaults to every subject.
+	// +optional
+	Subjects []string `json:"subjects,omitempty"`
+
+	// SubjectRenameFormat renames the subjects in the destination, where "${subject}"
+	// stands for the source subject name, e.g. "dc1.${subject}"
+	// +optional
+	SubjectRenameFormat string `json:"subjectRenameFormat,omitempty"`
+
+	// Config holds additional exporter configuration properties. They take precedence
+	// over the properties derived from destinationRef.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+
+	// Paused pauses the exporter while true. The exporter resumes from its offset.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// SchemaExporterStatus defines the observed state of SchemaExporter.
+type SchemaExporterStatus struct {
+	// ExporterName is the name of the managed exporter in the registry
+	// +optional
+	ExporterName string `json:"exporterName,omitempty"`
+
+	// State is the exporter state reported by the registry: STARTING, RUNNING, PAUSED or ERROR
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Offset is the position of the exporter in the schemas topic of the registry
+	// +optional
+	Offset *int64 `json:"offset,omitempty"`
+
+	// StateChangeTime is the time of the last state change reported by the registry
+	// +optional
+	StateChangeTime *metav1.Time `json:"stateChangeTime,omitempty"`
+
+	// Trace is the error reported by the registry for an exporter in the ERROR state
+	// +optional
+	Trace string `json:"trace,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed SchemaExporter Spec
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the current state of the SchemaExporter resource.
+	//
+	// Standard condition types include:
+	// - "Ready": the exporter matches the spec and is not in the ERROR state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Registry",type=string,JSONPath=`.spec.registryRef.name`
+// +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=`.spec.destinationRef.name`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Offset",type=integer,JSONPath=`.status.offset`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SchemaExporter is the Schema for the schemaexporters API. It manages a schema exporter
+// of Confluent Schema Linking, which exports subjects to another registry.
+type SchemaExporter struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of SchemaExporter
+	// +required
+	Spec SchemaExporterSpec `json:"spec"`
+
+	// status defines the observed state of SchemaExporter
+	// +optional
+	Status SchemaExporterStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// SchemaExporterList contains a list of SchemaExporter
+type SchemaExporterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []SchemaExporter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SchemaExporter{}, &SchemaExporterList{})
+}
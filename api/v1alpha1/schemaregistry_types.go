@@ -34,33 +34,312 @@ const (
 	AuthTypeMTLS   AuthType = "MTLS"
 )
 
+// BasicAuthSecretRef selects the Secret and keys holding basic auth credentials
+type BasicAuthSecretRef struct {
+	// Name of the Secret
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// UsernameKey is the Secret key holding the username
+	// +optional
+	// +kubebuilder:default=username
+	UsernameKey string `json:"usernameKey,omitempty"`
+
+	// PasswordKey is the Secret key holding the password
+	// +optional
+	// +kubebuilder:default=password
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
+
+// BearerTokenSecretRef selects the Secret and key holding a bearer token
+type BearerTokenSecretRef struct {
+	// Name of the Secret
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// TokenKey is the Secret key holding the token
+	// +optional
+	// +kubebuilder:default=token
+	TokenKey string `json:"tokenKey,omitempty"`
+}
+
+// TLSSecretRef selects the Secret and keys holding a client certificate and private key
+type TLSSecretRef struct {
+	// Name of the Secret
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// CertKey is the Secret key holding the PEM encoded certificate
+	// +optional
+	// +kubebuilder:default=tls.crt
+	CertKey string `json:"certKey,omitempty"`
+
+	// KeyKey is the Secret key holding the PEM encoded private key
+	// +optional
+	// +kubebuilder:default=tls.key
+	KeyKey string `json:"keyKey,omitempty"`
+}
+
+// CASecretRef selects the Secret and key holding a PEM encoded CA bundle
+type CASecretRef struct {
+	// Name of the Secret
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key is the Secret key holding the CA bundle
+	// +optional
+	// +kubebuilder:default=ca.crt
+	Key string `json:"key,omitempty"`
+}
+
+// CAConfigMapRef selects the ConfigMap and key holding a PEM encoded CA bundle
+type CAConfigMapRef struct {
+	// Name of the ConfigMap
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key is the ConfigMap key holding the CA bundle
+	// +optional
+	// +kubebuilder:default=ca.crt
+	Key string `json:"key,omitempty"`
+}
+
+// CABundleSource selects a CA bundle from either a Secret or a ConfigMap
+type CABundleSource struct {
+	// SecretRef selects a CA bundle stored in a Secret
+	// +optional
+	SecretRef *CASecretRef `json:"secretRef,omitempty"`
+
+	// ConfigMapRef selects a CA bundle stored in a ConfigMap, e.g. one populated by trust-manager
+	// +optional
+	ConfigMapRef *CAConfigMapRef `json:"configMapRef,omitempty"`
+}
+
+// TLSVersion is a TLS protocol version
+// +kubebuilder:validation:Enum=TLSv1.2;TLSv1.3
+type TLSVersion string
+
+const (
+	TLSVersion12 TLSVersion = "TLSv1.2"
+	TLSVersion13 TLSVersion = "TLSv1.3"
+)
+
+// TLSConfig defines how connections to the Schema Registry are secured.
+// It applies to every auth type.
+type TLSConfig struct {
+	// CA is a CA bundle used to verify the registry server certificate. It is
+	// combined with the MTLS CA and the KafkaUser cluster CA when those are set.
+	// +optional
+	CA *CABundleSource `json:"ca,omitempty"`
+
+	// ServerName overrides the host name used for SNI and certificate verification
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// MinVersion is the minimum accepted TLS version
+	// +optional
+	MinVersion TLSVersion `json:"minVersion,omitempty"`
+
+	// CipherSuites restricts the TLS 1.2 cipher suites offered to the registry,
+	// by IANA name (e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256). TLS 1.3 suites are not configurable.
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+// FailoverStrategy defines how requests are spread over multiple registry endpoints
+// +kubebuilder:validation:Enum=Ordered;RoundRobin
+type FailoverStrategy string
+
+const (
+	FailoverStrategyOrdered    FailoverStrategy = "Ordered"
+	FailoverStrategyRoundRobin FailoverStrategy = "RoundRobin"
+)
+
+// RegistryFlavor selects the API used to talk to the registry
+// +kubebuilder:validation:Enum=Confluent;ApicurioV2;ApicurioV3;Glue
+type RegistryFlavor string
+
+const (
+	// RegistryFlavorConfluent is the Confluent Schema Registry REST API, also served by
+	// Karapace, Redpanda and the ccompat layer of Apicurio Registry
+	RegistryFlavorConfluent RegistryFlavor = "Confluent"
+	// RegistryFlavorApicurioV2 is the native Apicurio Registry 2.x API (/apis/registry/v2)
+	RegistryFlavorApicurioV2 RegistryFlavor = "ApicurioV2"
+	// RegistryFlavorApicurioV3 is the native Apicurio Registry 3.x API (/apis/registry/v3)
+	RegistryFlavorApicurioV3 RegistryFlavor = "ApicurioV3"
+	// RegistryFlavorGlue is the AWS Glue Schema Registry API
+	RegistryFlavorGlue RegistryFlavor = "Glue"
+)
+
+// RegistryMode is the mode of a registry or subject in the Confluent API
+// +kubebuilder:validation:Enum=READWRITE;READONLY;READONLY_OVERRIDE;IMPORT
+type RegistryMode string
+
+const (
+	// RegistryModeReadWrite accepts registrations and deletions
+	RegistryModeReadWrite RegistryMode = "READWRITE"
+	// RegistryModeReadOnly rejects registrations and deletions
+	RegistryModeReadOnly RegistryMode = "READONLY"
+	// RegistryModeReadOnlyOverride is READONLY that also applies to subjects with a mode of their own
+	RegistryModeReadOnlyOverride RegistryMode = "READONLY_OVERRIDE"
+	// RegistryModeImport accepts registrations with an explicit schema ID and version
+	RegistryModeImport RegistryMode = "IMPORT"
+)
+
+// ApicurioConfig configures how subjects map to Apicurio Registry artifacts
+type ApicurioConfig struct {
+	// GroupID is the artifact group that subjects are registered in.
+	// A subject maps to the artifact with the same ID in this group.
+	// +optional
+	// +kubebuilder:default=default
+	GroupID string `json:"groupId,omitempty"`
+
+	// Labels are set on every artifact registered by the operator
+	// (artifact properties on Apicurio Registry 2.x)
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// AWSCredentialsSecretRef selects the Secret and keys holding IAM access keys
+type AWSCredentialsSecretRef struct {
+	// Name of the Secret
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// AccessKeyIDKey is the Secret key holding the access key ID
+	// +optional
+	// +kubebuilder:default=accessKeyId
+	AccessKeyIDKey string `json:"accessKeyIdKey,omitempty"`
+
+	// SecretAccessKeyKey is the Secret key holding the secret access key
+	// +optional
+	// +kubebuilder:default=secretAccessKey
+	SecretAccessKeyKey string `json:"secretAccessKeyKey,omitempty"`
+
+	// SessionTokenKey is the Secret key holding a session token, read only when present
+	// +optional
+	// +kubebuilder:default=sessionToken
+	SessionTokenKey string `json:"sessionTokenKey,omitempty"`
+}
+
+// GlueConfig configures how subjects map to AWS Glue Schema Registry schemas
+type GlueConfig struct {
+	// Region of the Glue registry. url defaults to https://glue.<region>.amazonaws.com.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// RegistryName is the Glue registry that subjects are created in.
+	// A subject maps to the schema with the same name in this registry.
+	// +optional
+	// +kubebuilder:default=default-registry
+	RegistryName string `json:"registryName,omitempty"`
+
+	// CredentialsSecretRef selects IAM access keys used to sign requests. When unset,
+	// the operator uses its own credentials: IRSA (AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE
+	// of its service account) or the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment.
+	// +optional
+	CredentialsSecretRef *AWSCredentialsSecretRef `json:"credentialsSecretRef,omitempty"`
+}
+
+// ProxyConfig routes registry connections through an HTTP(S) proxy
+type ProxyConfig struct {
+	// URL of the proxy, e.g. http://proxy.example.com:3128
+	// +required
+	// +kubebuilder:validation:Pattern=`^https?://.*`
+	URL string `json:"url"`
+
+	// NoProxy lists hosts, domains (".example.com"), IPs or CIDRs that are
+	// reached directly, using the same syntax as the NO_PROXY environment variable
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+
+	// AuthSecretRef references a secret containing the proxy username and password
+	// +optional
+	AuthSecretRef *BasicAuthSecretRef `json:"authSecretRef,omitempty"`
+}
+
+// HeaderSecretKeyRef selects the Secret and key holding a header value
+type HeaderSecretKeyRef struct {
+	// Name of the Secret
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key is the Secret key holding the header value
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// HeaderValueSource selects the source of a header value
+type HeaderValueSource struct {
+	// SecretKeyRef selects a key of a Secret in the SchemaRegistry namespace
+	// +required
+	SecretKeyRef HeaderSecretKeyRef `json:"secretKeyRef"`
+}
+
+// HTTPHeader is an extra header sent with every request to the Schema Registry
+type HTTPHeader struct {
+	// Name of the header, e.g. target-sr-cluster
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value of the header. Exactly one of value or valueFrom must be set.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom reads the header value from a Secret
+	// +optional
+	ValueFrom *HeaderValueSource `json:"valueFrom,omitempty"`
+}
+
 // BasicAuthConfig holds basic authentication credentials
 type BasicAuthConfig struct {
 	// SecretRef references a secret containing username and password
-	// Expected keys: username, password
 	// +required
-	SecretRef string `json:"secretRef"`
+	SecretRef BasicAuthSecretRef `json:"secretRef"`
 }
 
 // BearerAuthConfig holds bearer token authentication
 type BearerAuthConfig struct {
 	// SecretRef references a secret containing bearer token
-	// Expected key: token
 	// +required
-	SecretRef string `json:"secretRef"`
+	SecretRef BearerTokenSecretRef `json:"secretRef"`
 }
 
 // MTLSConfig holds mutual TLS configuration
 type MTLSConfig struct {
 	// CertSecretRef references a secret containing client certificate and key
-	// Expected keys: tls.crt, tls.key
 	// +required
-	CertSecretRef string `json:"certSecretRef"`
+	CertSecretRef TLSSecretRef `json:"certSecretRef"`
 
 	// CASecretRef references a secret containing CA certificate
+	// +optional
+	CASecretRef *CASecretRef `json:"caSecretRef,omitempty"`
+}
+
+// KafkaUserRef references the credentials the Strimzi User Operator created for a KafkaUser
+type KafkaUserRef struct {
+	// Name of the KafkaUser. The User Operator stores its credentials in a Secret
+	// with the same name, and for BASIC auth the name is also used as the username.
+	// Expected keys: password (BASIC), user.crt and user.key (MTLS)
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// ClusterCASecretRef references the Strimzi cluster CA Secret, usually
+	// "<cluster>-cluster-ca-cert", used to verify the registry server certificate
 	// Expected key: ca.crt
 	// +optional
-	CASecretRef string `json:"caSecretRef,omitempty"`
+	ClusterCASecretRef string `json:"clusterCASecretRef,omitempty"`
 }
 
 // AuthConfig defines authentication configuration for Schema Registry
@@ -81,34 +360,119 @@ type AuthConfig struct {
 	// MTLS configuration (used when type is MTLS)
 	// +optional
 	MTLS *MTLSConfig `json:"mtls,omitempty"`
+
+	// KafkaUserRef takes the credentials from a Strimzi KafkaUser instead of
+	// basicAuth or mtls. Supported with the BASIC (SCRAM-SHA-512) and MTLS (TLS user) types.
+	// +optional
+	KafkaUserRef *KafkaUserRef `json:"kafkaUserRef,omitempty"`
 }
 
 // SchemaRegistrySpec defines the desired state of SchemaRegistry
 type SchemaRegistrySpec struct {
-	// URL is the endpoint URL of the Schema Registry
-	// +required
+	// URL is the endpoint URL of the Schema Registry.
+	// Exactly one of url or urls must be set, except for the Glue flavor.
+	// +optional
 	// +kubebuilder:validation:Pattern=`^https?://.*`
-	URL string `json:"url"`
+	URL string `json:"url,omitempty"`
+
+	// URLs lists several endpoints of the same Schema Registry cluster, e.g. one per zone.
+	// Requests fail over to the next endpoint on connection errors and 5xx responses.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^https?://.*`
+	URLs []string `json:"urls,omitempty"`
+
+	// Flavor selects the registry API. ApicurioV2 and ApicurioV3 use the native
+	// Apicurio Registry API, with url pointing at the registry root instead of a
+	// Confluent compatible endpoint. Glue uses the AWS Glue Schema Registry API,
+	// configured in glue; url is optional for it.
+	// +optional
+	// +kubebuilder:default=Confluent
+	Flavor RegistryFlavor `json:"flavor,omitempty"`
+
+	// Apicurio configures the artifact group and labels for the Apicurio flavors
+	// +optional
+	Apicurio *ApicurioConfig `json:"apicurio,omitempty"`
+
+	// Glue configures the region, registry and credentials for the Glue flavor
+	// +optional
+	Glue *GlueConfig `json:"glue,omitempty"`
+
+	// FailoverStrategy selects the order in which urls are tried.
+	// Ordered always starts with the first URL, RoundRobin rotates the starting URL per request.
+	// +optional
+	// +kubebuilder:default=Ordered
+	FailoverStrategy FailoverStrategy `json:"failoverStrategy,omitempty"`
 
 	// Auth defines authentication configuration
 	// +optional
 	Auth *AuthConfig `json:"auth,omitempty"`
 
+	// TLS configures the CA bundle, server name and protocol settings for HTTPS registries
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// Proxy routes requests to the Schema Registry through an HTTP(S) proxy
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// Headers are extra HTTP headers sent with every request, e.g. target-sr-cluster
+	// for Confluent Cloud
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Headers []HTTPHeader `json:"headers,omitempty"`
+
 	// InsecureSkipVerify controls whether to skip TLS certificate verification
 	// +optional
 	// +kubebuilder:default=false
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 
+	// HealthCheckInterval is the time between health checks of the registry (in seconds)
+	// +optional
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=10
+	HealthCheckInterval int `json:"healthCheckInterval,omitempty"`
+
 	// Timeout for requests to Schema Registry (in seconds)
 	// +optional
 	// +kubebuilder:default=30
 	// +kubebuilder:validation:Minimum=1
 	Timeout int `json:"timeout,omitempty"`
+
+	// Mode is the global mode applied to the registry on every health check, e.g. READONLY
+	// or IMPORT during a migration. The mode is left unmanaged when empty.
+	// Only supported by registries with the Confluent API.
+	// +optional
+	Mode RegistryMode `json:"mode,omitempty"`
+
+	// Suspend stops health checks against the registry while true.
+	// Removing it (or setting it to false) resumes reconciliation immediately.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// EndpointStatus reports the result of the last health check of a single registry URL
+type EndpointStatus struct {
+	// URL of the endpoint
+	URL string `json:"url"`
+
+	// Reachable is true when the last health check of this endpoint succeeded
+	Reachable bool `json:"reachable"`
+
+	// Message holds the health check error of an unreachable endpoint
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LatencyMilliseconds is the duration of the last health check request
+	// +optional
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
 }
 
 // SchemaRegistryStatus defines the observed state of SchemaRegistry.
 type SchemaRegistryStatus struct {
-	// ConnectionStatus indicates whether the registry is reachable
+	// ConnectionStatus indicates whether the registry is reachable:
+	// Connected, Degraded (some endpoints unreachable) or Unreachable
 	// +optional
 	ConnectionStatus string `json:"connectionStatus,omitempty"`
 
@@ -116,6 +480,43 @@ type SchemaRegistryStatus struct {
 	// +optional
 	LastChecked *metav1.Time `json:"lastChecked,omitempty"`
 
+	// ServerVersion is the registry version reported by /v1/metadata/version, when available
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// DetectedFlavor is the registry implementation found by the health check:
+	// Confluent, Karapace or Redpanda for the Confluent flavor, otherwise the
+	// configured Apicurio flavor. Clients skip the server version lookup on
+	// Karapace and Redpanda and subject mode changes on Karapace.
+	// +optional
+	DetectedFlavor string `json:"detectedFlavor,omitempty"`
+
+	// CompatibilityLevel is the global compatibility level of the registry
+	// +optional
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+
+	// Mode is the global mode of the registry, e.g. READWRITE or READONLY
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// SchemaTypes lists the schema types supported by the registry
+	// +optional
+	SchemaTypes []string `json:"schemaTypes,omitempty"`
+
+	// SubjectCount is the number of subjects registered in the registry
+	// +optional
+	SubjectCount *int `json:"subjectCount,omitempty"`
+
+	// LatencyMilliseconds is the duration of the last subject listing request
+	// +optional
+	LatencyMilliseconds *int64 `json:"latencyMilliseconds,omitempty"`
+
+	// Endpoints reports the reachability of each configured registry URL
+	// +optional
+	// +listType=map
+	// +listMapKey=url
+	Endpoints []EndpointStatus `json:"endpoints,omitempty"`
+
 	// ObservedGeneration reflects the generation of the most recently observed SchemaRegistry Spec
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -137,6 +538,14 @@ type SchemaRegistryStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.connectionStatus`
+// +kubebuilder:printcolumn:name="Flavor",type=string,JSONPath=`.status.detectedFlavor`,priority=1
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.serverVersion`,priority=1
+// +kubebuilder:printcolumn:name="Compatibility",type=string,JSONPath=`.status.compatibilityLevel`,priority=1
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.status.mode`,priority=1
+// +kubebuilder:printcolumn:name="Subjects",type=integer,JSONPath=`.status.subjectCount`,priority=1
+// +kubebuilder:printcolumn:name="Latency(ms)",type=integer,JSONPath=`.status.latencyMilliseconds`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // SchemaRegistry is the Schema for the schemaregistries API
 type SchemaRegistry struct {
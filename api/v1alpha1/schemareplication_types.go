@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubjectFilter selects subjects by name
+type SubjectFilter struct {
+	// Include lists regular expressions, a subject matching any of them is selected.
+	// All subjects are selected when empty.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// Exclude lists regular expressions, a subject matching any of them is not selected
+	// even when it matches include
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// SchemaReplicationSpec defines the desired state of SchemaReplication
+type SchemaReplicationSpec struct {
+	// SourceRef references the SchemaRegistry the subjects are copied from
+	// +required
+	SourceRef SchemaRegistryRef `json:"sourceRef"`
+
+	// TargetRef references the SchemaRegistry the subjects are copied to
+	// +required
+	TargetRef SchemaRegistryRef `json:"targetRef"`
+
+	// Subjects selects the replicated subjects. Subjects referenced by a selected
+	// subject are always replicated, so the references resolve in the target.
+	// +optional
+	Subjects SubjectFilter `json:"subjects,omitzero"`
+
+	// PreserveIDs registers every version in the target under its schema ID in the
+	// source, switching the target subject to IMPORT mode for the registration.
+	// Only supported by targets with the Confluent API.
+	// +optional
+	PreserveIDs bool `json:"preserveIds,omitempty"`
+
+	// SyncInterval is the time between synchronizations (in seconds)
+	// +optional
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=10
+	SyncInterval int `json:"syncInterval,omitempty"`
+
+	// Suspend stops the replication while true.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// ReplicationError describes a subject that failed to replicate.
+type ReplicationError struct {
+	// Subject is the source subject
+	// +required
+	Subject string `json:"subject"`
+
+	// Message describes the failure
+	// +required
+	Message string `json:"message"`
+}
+
+// SchemaReplicationStatus defines the observed state of SchemaReplication.
+type SchemaReplicationStatus struct {
+	// Subjects is the number of source subjects selected for replication
+	// +optional
+	Subjects int `json:"subjects,omitempty"`
+
+	// CopiedVersions is the number of versions registered in the target by the last synchronization
+	// +optional
+	CopiedVersions int `json:"copiedVersions,omitempty"`
+
+	// Lag is the number of source versions that are not confirmed in the target
+	// after the last synchronization, i.e. the versions of subjects that failed
+	// +optional
+	Lag int `json:"lag,omitempty"`
+
+	// Errors lists the subjects that failed in the last synchronization, at most 10
+	// +optional
+	Errors []ReplicationError `json:"errors,omitempty"`
+
+	// LastSyncTime is the time of the last synchronization
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed SchemaReplication Spec
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the current state of the SchemaReplication resource.
+	//
+	// Standard condition types include:
+	// - "Ready": every selected subject is replicated to the target
+	// - "Suspended": the replication is stopped by spec.suspend
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceRef.name`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetRef.name`
+// +kubebuilder:printcolumn:name="Subjects",type=integer,JSONPath=`.status.subjects`
+// +kubebuilder:printcolumn:name="Lag",type=integer,JSONPath=`.status.lag`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`,priority=1
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SchemaReplication is the Schema for the schemareplications API. It continuously
+// copies the versions of selected subjects from one registry to another.
+type SchemaReplication struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of SchemaReplication
+	// +required
+	Spec SchemaReplicationSpec `json:"spec"`
+
+	// status defines the observed state of SchemaReplication
+	// +optional
+	Status SchemaReplicationStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// SchemaReplicationList contains a list of SchemaReplication
+type SchemaReplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []SchemaReplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SchemaReplication{}, &SchemaReplicationList{})
+}
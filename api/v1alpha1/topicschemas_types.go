@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeletionPolicy defines what happens to registry subjects when the resource is deleted
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete removes the subjects from the registry when the resource is deleted
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain leaves the subjects in the registry when the resource is deleted
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// TopicSchemaDefinition describes the schema registered for the key or the value of a topic
+type TopicSchemaDefinition struct {
+	// SchemaType defines the type of schema (AVRO, JSON, PROTOBUF)
+	// +required
+	// +kubebuilder:default=AVRO
+	SchemaType SchemaType `json:"schemaType"`
+
+	// Schema is the actual schema definition
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Schema string `json:"schema"`
+
+	// References to other schemas (for nested/imported schemas)
+	// +optional
+	References []SchemaReference `json:"references,omitempty"`
+
+	// CompatibilityLevel defines the compatibility checking mode for this subject
+	// +optional
+	// +kubebuilder:validation:Enum=BACKWARD;BACKWARD_TRANSITIVE;FORWARD;FORWARD_TRANSITIVE;FULL;FULL_TRANSITIVE;NONE
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+}
+
+// TopicSchemasSpec defines the desired state of TopicSchemas
+type TopicSchemasSpec struct {
+	// Topic is the Kafka topic name. The key and value schemas are registered
+	// under the subjects "<topic>-key" and "<topic>-value" (TopicNameStrategy).
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Topic string `json:"topic"`
+
+	// Key is the schema for the record key
+	// +optional
+	Key *TopicSchemaDefinition `json:"key,omitempty"`
+
+	// Value is the schema for the record value
+	// +optional
+	Value *TopicSchemaDefinition `json:"value,omitempty"`
+
+	// RegistryRef references the Schema Registry endpoint configuration
+	// +required
+	RegistryRef SchemaRegistryRef `json:"registryRef"`
+
+	// DeletionPolicy controls whether both subjects are deleted from the registry
+	// when this resource is deleted
+	// +optional
+	// +kubebuilder:default=Delete
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// SubjectStatus describes the registration state of a single subject.
+type SubjectStatus struct {
+	// Subject is the registry subject name
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// SchemaID is the ID assigned by the Schema Registry
+	// +optional
+	SchemaID *int `json:"schemaId,omitempty"`
+
+	// Version is the version number of the registered schema
+	// +optional
+	Version *int `json:"version,omitempty"`
+
+	// RegisteredAt is the timestamp when the schema was registered
+	// +optional
+	RegisteredAt *metav1.Time `json:"registeredAt,omitempty"`
+
+	// CompatibilityLevel is the subject compatibility level last applied to the registry
+	// +optional
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+}
+
+// TopicSchemasStatus defines the observed state of TopicSchemas.
+type TopicSchemasStatus struct {
+	// Key is the registration state of the key subject
+	// +optional
+	Key *SubjectStatus `json:"key,omitempty"`
+
+	// Value is the registration state of the value subject
+	// +optional
+	Value *SubjectStatus `json:"value,omitempty"`
+
+	// Subjects lists the subjects registered by this resource. A subject that drops out of
+	// the spec is deleted from the registry, unless the deletion policy is Retain, and then
+	// removed from the list.
+	// +listType=set
+	// +optional
+	Subjects []string `json:"subjects,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed TopicSchemas Spec
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the current state of the TopicSchemas resource.
+	//
+	// Standard condition types include:
+	// - "Ready": both the key and the value schemas are registered in the registry
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Topic",type=string,JSONPath=`.spec.topic`
+// +kubebuilder:printcolumn:name="Key Version",type=integer,JSONPath=`.status.key.version`
+// +kubebuilder:printcolumn:name="Value Version",type=integer,JSONPath=`.status.value.version`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TopicSchemas is the Schema for the topicschemas API. It manages the key and
+// value schemas of a single topic as one unit.
+type TopicSchemas struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of TopicSchemas
+	// +required
+	Spec TopicSchemasSpec `json:"spec"`
+
+	// status defines the observed state of TopicSchemas
+	// +optional
+	Status TopicSchemasStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// TopicSchemasList contains a list of TopicSchemas
+type TopicSchemasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []TopicSchemas `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TopicSchemas{}, &TopicSchemasList{})
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+	"github.com/honza/schema-strimzi-operator/internal/controller"
+	"github.com/honza/schema-strimzi-operator/internal/schemadiff"
+)
+
+// specVersion selects the Schema's own definition in kubectl schema diff.
+const specVersion = "spec"
+
+// plugin holds what every command needs: the cluster client and the target namespace.
+type plugin struct {
+	client    client.Client
+	namespace string
+	out       io.Writer
+}
+
+type command struct {
+	synopsis         string
+	minArgs, maxArgs int
+	run              func(p *plugin, ctx context.Context, args []string) error
+}
+
+var commands = map[string]command{
+	"versions": {synopsis: "versions NAME", minArgs: 1, maxArgs: 1, run: (*plugin).versions},
+	"diff":     {synopsis: "diff NAME [FROM [TO]]", minArgs: 1, maxArgs: 3, run: (*plugin).diff},
+	"refs":     {synopsis: "refs NAME", minArgs: 1, maxArgs: 1, run: (*plugin).refs},
+	"resync":   {synopsis: "resync NAME", minArgs: 1, maxArgs: 1, run: (*plugin).resync},
+}
+
+// getSchema fetches a Schema and builds a client for the registry it references,
+// the primary one when it references several.
+func (p *plugin) getSchema(ctx context.Context, name string) (*registryv1alpha1.Schema, schemaclient.Registry, error) {
+	var schema registryv1alpha1.Schema
+	if err := p.client.Get(ctx, types.NamespacedName{Namespace: p.namespace, Name: name}, &schema); err != nil {
+		return nil, nil, err
+	}
+	srClient, err := controller.BuildRegistryClient(ctx, p.client, schema.Namespace, controller.SchemaRegistryRefs(&schema)[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return &schema, srClient, nil
+}
+
+// versions lists the registered versions of the subject. The version recorded in
+// the Schema status is marked with "*".
+func (p *plugin) versions(ctx context.Context, args []string) error {
+	schema, srClient, err := p.getSchema(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	versions, err := srClient.GetSubjectVersions(ctx, schema.Spec.Subject)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		fmt.Fprintf(p.out, "Subject %s has no registered versions.\n", schema.Spec.Subject)
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "\tVERSION\tID\tTYPE\tREFERENCES")
+	for _, version := range versions {
+		registered, err := srClient.GetSchema(ctx, schema.Spec.Subject, strconv.Itoa(version))
+		if err != nil {
+			return err
+		}
+		marker := ""
+		if schema.Status.Version != nil && *schema.Status.Version == version {
+			marker = "*"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\n", marker, registered.Version, registered.ID,
+			schemaTypeOf(registered.SchemaType), formatReferences(registered.References))
+	}
+	return tw.Flush()
+}
+
+// diff prints the differences between two versions of the subject. Without
+// versions it compares the latest version with the previous existing one.
+func (p *plugin) diff(ctx context.Context, args []string) error {
+	schema, srClient, err := p.getSchema(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	from, to := "", "latest"
+	switch len(args) {
+	case 2:
+		from = args[1]
+	case 3:
+		from, to = args[1], args[2]
+	}
+	if from == "" {
+		// Soft-deleted versions leave gaps, so the previous version is looked up in the list
+		versions, err := srClient.GetSubjectVersions(ctx, schema.Spec.Subject)
+		if err != nil {
+			return err
+		}
+		switch len(versions) {
+		case 0:
+			fmt.Fprintf(p.out, "Subject %s has no registered versions.\n", schema.Spec.Subject)
+			return nil
+		case 1:
+			fmt.Fprintf(p.out, "Subject %s has a single version %d, nothing to compare.\n", schema.Spec.Subject, versions[0])
+			return nil
+		}
+		slices.Sort(versions)
+		from, to = strconv.Itoa(versions[len(versions)-2]), strconv.Itoa(versions[len(versions)-1])
+	}
+
+	fromLabel, fromSchema, schemaType, err := resolveVersion(ctx, srClient, schema, from)
+	if err != nil {
+		return err
+	}
+	toLabel, toSchema, _, err := resolveVersion(ctx, srClient, schema, to)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(p.out, "--- %s\n+++ %s\n", fromLabel, toLabel)
+	lines := schemadiff.Diff(schemaType, fromSchema, toSchema)
+	if len(lines) == 0 {
+		fmt.Fprintln(p.out, "No differences.")
+	}
+	for _, line := range lines {
+		fmt.Fprintln(p.out, line)
+	}
+	return nil
+}
+
+// resolveVersion returns a label, the definition and the type of a version argument.
+func resolveVersion(ctx context.Context, srClient schemaclient.Registry, schema *registryv1alpha1.Schema, version string) (string, string, string, error) {
+	if version == specVersion {
+		return fmt.Sprintf("Schema %s/%s (spec)", schema.Namespace, schema.Name), controller.CurrentSchema(schema),
+			schemaTypeOf(string(schema.Spec.SchemaType)), nil
+	}
+	if _, err := strconv.Atoi(version); err != nil && version != "latest" {
+		return "", "", "", fmt.Errorf("invalid version %q, expected a number, \"latest\" or %q", version, specVersion)
+	}
+	registered, err := srClient.GetSchema(ctx, schema.Spec.Subject, version)
+	if err != nil {
+		return "", "", "", err
+	}
+	label := fmt.Sprintf("%s version %d (ID %d)", schema.Spec.Subject, registered.Version, registered.ID)
+	return label, registered.Schema, schemaTypeOf(registered.SchemaType), nil
+}
+
+// refs prints the references of the Schema as a tree, following the references
+// of each referenced version in the registry.
+func (p *plugin) refs(ctx context.Context, args []string) error {
+	schema, srClient, err := p.getSchema(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(p.out, "%s (Schema %s/%s)\n", schema.Spec.Subject, schema.Namespace, schema.Name)
+	refs := make([]schemaclient.SchemaReference, 0, len(schema.Spec.References))
+	for _, ref := range schema.Spec.References {
+		refs = append(refs, schemaclient.SchemaReference{Name: ref.Name, Subject: ref.Subject, Version: ref.Version})
+	}
+	return p.printRefs(ctx, srClient, refs, "", map[string]bool{schema.Spec.Subject: true})
+}
+
+// printRefs prints one level of the reference tree. path holds the subjects on the
+// way from the root, so that cyclic references are reported instead of followed.
+func (p *plugin) printRefs(ctx context.Context, srClient schemaclient.Registry, refs []schemaclient.SchemaReference, indent string, path map[string]bool) error {
+	for i, ref := range refs {
+		branch, childIndent := "├── ", indent+"│   "
+		if i == len(refs)-1 {
+			branch, childIndent = "└── ", indent+"    "
+		}
+
+		line := fmt.Sprintf("%s%s%s: %s version %d", indent, branch, ref.Name, ref.Subject, ref.Version)
+		if path[ref.Subject] {
+			fmt.Fprintf(p.out, "%s (cycle)\n", line)
+			continue
+		}
+		registered, err := srClient.GetSchema(ctx, ref.Subject, strconv.Itoa(ref.Version))
+		if err != nil {
+			// A broken reference is what this command is usually run to find, keep printing the tree
+			fmt.Fprintf(p.out, "%s (%v)\n", line, err)
+			continue
+		}
+		fmt.Fprintf(p.out, "%s (ID %d)\n", line, registered.ID)
+
+		path[ref.Subject] = true
+		if err := p.printRefs(ctx, srClient, registered.References, childIndent, path); err != nil {
+			return err
+		}
+		delete(path, ref.Subject)
+	}
+	return nil
+}
+
+// resync sets the resync annotation to the current time, which makes the
+// controller reconcile the Schema right away. The time has nanosecond precision,
+// so two resyncs within the same second still change the annotation.
+func (p *plugin) resync(ctx context.Context, args []string) error {
+	schema := &registryv1alpha1.Schema{}
+	schema.Namespace, schema.Name = p.namespace, args[0]
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`,
+		registryv1alpha1.ResyncAnnotation, time.Now().UTC().Format(time.RFC3339Nano))
+	if err := p.client.Patch(ctx, schema, client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+		return err
+	}
+	fmt.Fprintf(p.out, "schema.registry.strimzi.io/%s resync requested\n", args[0])
+	return nil
+}
+
+// schemaTypeOf applies the registry default for an empty schema type.
+func schemaTypeOf(schemaType string) string {
+	if schemaType == "" {
+		return string(registryv1alpha1.SchemaTypeAvro)
+	}
+	return schemaType
+}
+
+func formatReferences(refs []schemaclient.SchemaReference) string {
+	if len(refs) == 0 {
+		return "<none>"
+	}
+	parts := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		parts = append(parts, fmt.Sprintf("%s@%d", ref.Subject, ref.Version))
+	}
+	return strings.Join(parts, ",")
+}
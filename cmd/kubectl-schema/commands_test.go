@@ -0,0 +1,245 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
+)
+
+// newTestPlugin serves orders-value with two versions, the latest referencing
+// customer-value, which in turn references a version that does not exist.
+func newTestPlugin(t *testing.T) (*plugin, *bytes.Buffer) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/subjects/orders-value/versions", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[1,2]`))
+	})
+	mux.HandleFunc("/subjects/orders-value/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"orders-value","id":7,"version":1,` +
+			`"schema":"{\"type\":\"record\",\"name\":\"Order\",\"fields\":[{\"name\":\"id\",\"type\":\"string\"}]}"}`))
+	})
+	latest := `{"subject":"orders-value","id":9,"version":2,` +
+		`"schema":"{\"type\":\"record\",\"name\":\"Order\",\"fields\":[{\"name\":\"id\",\"type\":\"string\"},` +
+		`{\"name\":\"customer\",\"type\":\"com.example.Customer\"}]}",` +
+		`"references":[{"name":"com.example.Customer","subject":"customer-value","version":1}]}`
+	mux.HandleFunc("/subjects/orders-value/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(latest))
+	})
+	mux.HandleFunc("/subjects/orders-value/versions/latest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(latest))
+	})
+	mux.HandleFunc("/subjects/customer-value/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"customer-value","id":5,"version":1,"schema":"{}",` +
+			`"references":[{"name":"com.example.Address","subject":"address-value","version":3}]}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	version := 1
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&registryv1alpha1.SchemaRegistry{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "kafka"},
+			Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+		},
+		&registryv1alpha1.Schema{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "kafka"},
+			Spec: registryv1alpha1.SchemaSpec{
+				Subject:     "orders-value",
+				SchemaType:  registryv1alpha1.SchemaTypeAvro,
+				Schema:      `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"}]}`,
+				References:  []registryv1alpha1.SchemaReference{{Name: "com.example.Customer", Subject: "customer-value", Version: 1}},
+				RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: "registry"},
+			},
+			Status: registryv1alpha1.SchemaStatus{Version: &version},
+		},
+	).Build()
+
+	var out bytes.Buffer
+	return &plugin{client: k8sClient, namespace: "kafka", out: &out}, &out
+}
+
+func TestVersions(t *testing.T) {
+	p, out := newTestPlugin(t)
+	if err := p.versions(context.Background(), []string{"orders"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `   VERSION  ID  TYPE  REFERENCES
+*  1        7   AVRO  <none>
+   2        9   AVRO  customer-value@1
+`
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	p, out := newTestPlugin(t)
+	if err := p.diff(context.Background(), []string{"orders"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `--- orders-value version 1 (ID 7)
++++ orders-value version 2 (ID 9)
++ fields[customer]: {"name":"customer","type":"com.example.Customer"}
+`
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	if err := p.diff(context.Background(), []string{"orders", "1", specVersion}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "+++ Schema kafka/orders (spec)\n") ||
+		!strings.Contains(out.String(), `~ fields[id].type: "string" -> "long"`) {
+		t.Errorf("expected a diff against the spec, got:\n%s", out.String())
+	}
+
+	if err := p.diff(context.Background(), []string{"orders", "first"}); err == nil {
+		t.Error("expected an invalid version to be rejected")
+	}
+}
+
+func TestDiff_PreviousExistingVersion(t *testing.T) {
+	srv := registrytest.NewServer()
+	t.Cleanup(srv.Close)
+	for _, schema := range []string{`"int"`, `"long"`, `"double"`} {
+		if _, _, err := srv.Register("orders-value", registrytest.RegisterRequest{Schema: schema}); err != nil {
+			t.Fatalf("failed to seed the registry: %v", err)
+		}
+	}
+	if _, _, err := srv.Register("users-value", registrytest.RegisterRequest{Schema: `"string"`}); err != nil {
+		t.Fatalf("failed to seed the registry: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodDelete, srv.URL+"/subjects/orders-value/versions/2", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to soft-delete version 2: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	schemaFor := func(name, subject string) *registryv1alpha1.Schema {
+		return &registryv1alpha1.Schema{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kafka"},
+			Spec: registryv1alpha1.SchemaSpec{
+				Subject:     subject,
+				SchemaType:  registryv1alpha1.SchemaTypeAvro,
+				Schema:      `"double"`,
+				RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: "registry"},
+			},
+		}
+	}
+	var out bytes.Buffer
+	p := &plugin{
+		client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "kafka"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			},
+			schemaFor("orders", "orders-value"),
+			schemaFor("users", "users-value"),
+		).Build(),
+		namespace: "kafka",
+		out:       &out,
+	}
+
+	if err := p.diff(context.Background(), []string{"orders"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "--- orders-value version 1 (ID 1)\n+++ orders-value version 3 (ID 3)\n") {
+		t.Errorf("expected the soft-deleted version to be skipped, got:\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := p.diff(context.Background(), []string{"users"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "Subject users-value has a single version 1, nothing to compare.\n" {
+		t.Errorf("unexpected output for a single version:\n%s", out.String())
+	}
+}
+
+func TestRefs(t *testing.T) {
+	p, out := newTestPlugin(t)
+	if err := p.refs(context.Background(), []string{"orders"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `orders-value (Schema kafka/orders)
+└── com.example.Customer: customer-value version 1 (ID 5)
+    └── com.example.Address: address-value version 3 (version 3 of subject address-value not found)
+`
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestResync(t *testing.T) {
+	p, out := newTestPlugin(t)
+	if err := p.resync(context.Background(), []string{"orders"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var schema registryv1alpha1.Schema
+	if err := p.client.Get(context.Background(), types.NamespacedName{Namespace: "kafka", Name: "orders"}, &schema); err != nil {
+		t.Fatal(err)
+	}
+	first := schema.Annotations[registryv1alpha1.ResyncAnnotation]
+	if _, err := time.Parse(time.RFC3339Nano, first); err != nil {
+		t.Errorf("expected a timestamp in the resync annotation, got %v", schema.Annotations)
+	}
+	if out.String() != "schema.registry.strimzi.io/orders resync requested\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+
+	// A second resync right away must still change the annotation
+	if err := p.resync(context.Background(), []string{"orders"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.client.Get(context.Background(), types.NamespacedName{Namespace: "kafka", Name: "orders"}, &schema); err != nil {
+		t.Fatal(err)
+	}
+	if second := schema.Annotations[registryv1alpha1.ResyncAnnotation]; second == first {
+		t.Errorf("expected the second resync to change the annotation, both are %q", first)
+	}
+}
+
+func TestParseInterspersed(t *testing.T) {
+	var flags kubeFlags
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.bind(fs)
+	positional, err := parseInterspersed(fs, []string{"orders", "-n", "kafka", "1", "--context", "prod", "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flags.namespace != "kafka" || flags.context != "prod" || strings.Join(positional, ",") != "orders,1,2" {
+		t.Errorf("unexpected parse: flags %+v, args %v", flags, positional)
+	}
+}
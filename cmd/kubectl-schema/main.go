@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command kubectl-schema is a kubectl plugin that shows Schema resources next to
+// their state in the Schema Registry. Install it on the PATH and run "kubectl schema".
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+const usage = `kubectl schema inspects Schema resources and their state in the Schema Registry.
+
+Usage:
+  kubectl schema <command> NAME [args] [flags]
+
+Commands:
+  versions NAME           List the registered versions of the subject of a Schema
+  diff NAME [FROM [TO]]   Diff two versions, by default the latest against the one before it;
+                          a version is a number, "latest" or "spec" for the Schema's own definition
+  refs NAME               Show the reference tree of a Schema
+  resync NAME             Trigger an immediate reconcile of a Schema
+
+Flags:
+  -n, --namespace string   Namespace of the Schema, defaults to the namespace of the current context
+  --kubeconfig string      Path to the kubeconfig file
+  --context string         Name of the kubeconfig context to use
+
+Requirements:
+  versions, diff and refs connect to the Schema Registry directly, the same way the
+  controller does. They read the SchemaRegistry of the Schema and the Secrets it
+  references (auth, TLS, proxy), so your account needs get access to those Secrets,
+  and the registry URL must be reachable from this machine, e.g. through a VPN or
+  kubectl port-forward. resync only needs patch access to the Schema.
+`
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(registryv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	command := os.Args[1]
+	if command == "help" || command == "-h" || command == "--help" {
+		fmt.Fprint(os.Stdout, usage)
+		return
+	}
+
+	err := run(ctx, command, os.Args[2:], os.Stdout)
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "kubectl schema %s: %v\n", command, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+// run parses the flags and positional arguments of a command and executes it.
+func run(ctx context.Context, command string, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), usage) }
+	var flags kubeFlags
+	flags.bind(fs)
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	cmd, ok := commands[command]
+	if !ok {
+		return fmt.Errorf("unknown command %q, run \"kubectl schema help\" for usage", command)
+	}
+	if len(positional) < cmd.minArgs || len(positional) > cmd.maxArgs {
+		return fmt.Errorf("usage: kubectl schema %s", cmd.synopsis)
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = flags.kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules, &clientcmd.ConfigOverrides{CurrentContext: flags.context})
+	namespace := flags.namespace
+	if namespace == "" {
+		if namespace, _, err = clientConfig.Namespace(); err != nil {
+			return fmt.Errorf("failed to determine the namespace: %w", err)
+		}
+	}
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	p := &plugin{client: k8sClient, namespace: namespace, out: stdout}
+	return cmd.run(p, ctx, positional)
+}
+
+// kubeFlags holds the kubectl style flags selecting the cluster and namespace.
+type kubeFlags struct {
+	namespace  string
+	kubeconfig string
+	context    string
+}
+
+func (f *kubeFlags) bind(fs *flag.FlagSet) {
+	fs.StringVar(&f.namespace, "n", "", "Namespace of the Schema")
+	fs.StringVar(&f.namespace, "namespace", "", "Namespace of the Schema")
+	fs.StringVar(&f.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file")
+	fs.StringVar(&f.context, "context", "", "Name of the kubeconfig context to use")
+}
+
+// parseInterspersed parses flags placed anywhere among the positional arguments,
+// as kubectl does, and returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
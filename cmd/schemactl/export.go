@@ -0,0 +1,400 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+)
+
+// exportOptions controls which subjects are exported and how the manifests look.
+type exportOptions struct {
+	// registryName is the name of the generated SchemaRegistry, referenced by every Schema
+	registryName string
+	// namespace of the generated manifests, left out when empty
+	namespace string
+	// credentialsSecret is the Secret referenced by the generated SchemaRegistry auth
+	credentialsSecret string
+	// subjects selects the exported subjects; referenced subjects are always included
+	subjects *regexp.Regexp
+	// allVersions exports every live version of a subject instead of only the latest
+	allVersions bool
+}
+
+// manifestFile is a group of manifests written to one file.
+type manifestFile struct {
+	name    string
+	objects []any
+}
+
+// kustomization is the kustomization.yaml written next to the exported manifests.
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Resources  []string `json:"resources"`
+}
+
+func runExport(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("export", "Generate SchemaRegistry and Schema manifests from a live registry.")
+	var registry registryFlags
+	registry.bind(fs)
+
+	var opts exportOptions
+	var subjectPattern, outputDir string
+	fs.StringVar(&opts.registryName, "registry-name", "schema-registry", "Name of the generated SchemaRegistry")
+	fs.StringVar(&opts.namespace, "namespace", "", "Namespace of the generated manifests")
+	fs.StringVar(&opts.credentialsSecret, "credentials-secret", "",
+		"Secret referenced by the generated SchemaRegistry auth (default <registry-name>-credentials)")
+	fs.StringVar(&subjectPattern, "subject", "", "Regular expression selecting the exported subjects (default all)")
+	fs.BoolVar(&opts.allVersions, "all-versions", false, "Export every live version of a subject in spec.versions instead of only the latest")
+	fs.StringVar(&outputDir, "output-dir", "",
+		"Write one file per subject and a kustomization.yaml to this directory instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if subjectPattern != "" {
+		var err error
+		if opts.subjects, err = regexp.Compile(subjectPattern); err != nil {
+			return fmt.Errorf("invalid --subject: %w", err)
+		}
+	}
+	if opts.credentialsSecret == "" {
+		opts.credentialsSecret = opts.registryName + "-credentials"
+	}
+
+	srClient, err := registry.newClient()
+	if err != nil {
+		return err
+	}
+
+	files, err := exportManifests(ctx, srClient, registry, opts)
+	if err != nil {
+		return err
+	}
+
+	if outputDir == "" {
+		return writeManifests(stdout, files)
+	}
+	return writeManifestDir(outputDir, files)
+}
+
+// subjectVersion is a version of a subject to export, a number or "latest".
+type subjectVersion struct {
+	subject string
+	version string
+}
+
+// exportManifests walks the subjects of the registry and builds the SchemaRegistry
+// manifest followed by one file with the Schema manifest of each subject.
+func exportManifests(ctx context.Context, srClient schemaclient.Registry, registry registryFlags, opts exportOptions) ([]manifestFile, error) {
+	subjects, err := srClient.ListSubjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(subjects)
+
+	var queue []subjectVersion
+	for _, subject := range subjects {
+		if opts.subjects != nil && !opts.subjects.MatchString(subject) {
+			continue
+		}
+		if !opts.allVersions {
+			queue = append(queue, subjectVersion{subject: subject, version: "latest"})
+			continue
+		}
+		numbers, err := srClient.GetSubjectVersions(ctx, subject)
+		if err != nil {
+			return nil, fmt.Errorf("subject %s: %w", subject, err)
+		}
+		for _, number := range numbers {
+			queue = append(queue, subjectVersion{subject: subject, version: strconv.Itoa(number)})
+		}
+	}
+
+	// Referenced subjects are exported at the referenced version, so the manifests apply to an empty registry
+	var order []string
+	history := map[string]map[int]*schemaclient.SchemaResponse{}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		schema, err := srClient.GetSchema(ctx, next.subject, next.version)
+		if err != nil {
+			return nil, fmt.Errorf("subject %s: %w", next.subject, err)
+		}
+		versions := history[next.subject]
+		if versions == nil {
+			versions = map[int]*schemaclient.SchemaResponse{}
+			history[next.subject] = versions
+			order = append(order, next.subject)
+		}
+		if versions[schema.Version] != nil {
+			continue
+		}
+		versions[schema.Version] = schema
+
+		for _, ref := range schema.References {
+			if history[ref.Subject][ref.Version] == nil {
+				queue = append(queue, subjectVersion{subject: ref.Subject, version: strconv.Itoa(ref.Version)})
+			}
+		}
+	}
+
+	// An empty registry numbers the exported versions of a subject from 1, without the gaps
+	// left by soft-deleted or skipped versions, so references use those numbers
+	renumbered := make(map[string]map[int]int, len(history))
+	for subject, versions := range history {
+		renumbered[subject] = map[int]int{}
+		for i, number := range slices.Sorted(maps.Keys(versions)) {
+			renumbered[subject][number] = i + 1
+		}
+	}
+
+	sr, err := schemaRegistryManifest(registry, opts)
+	if err != nil {
+		return nil, err
+	}
+	files := []manifestFile{{
+		name:    "schemaregistry.yaml",
+		objects: []any{sr},
+	}}
+
+	names := map[string]bool{}
+	for _, subject := range order {
+		versions := history[subject]
+		numbers := slices.Sorted(maps.Keys(versions))
+		latest := versions[numbers[len(numbers)-1]]
+
+		// spec.references applies to every entry of spec.versions
+		for _, number := range numbers {
+			if !slices.Equal(versions[number].References, latest.References) {
+				return nil, fmt.Errorf("subject %s: versions %d and %d have different references, which one Schema cannot express",
+					subject, number, latest.Version)
+			}
+		}
+
+		compatibilityLevel, err := srClient.GetSubjectCompatibility(ctx, subject)
+		if err != nil {
+			return nil, fmt.Errorf("subject %s: %w", subject, err)
+		}
+
+		name := uniqueName(resourceName(subject), names)
+		manifest := schemaManifest(name, subject, latest, opts)
+		manifest.Spec.CompatibilityLevel = compatibilityLevel
+		for i, ref := range manifest.Spec.References {
+			manifest.Spec.References[i].Version = renumbered[ref.Subject][ref.Version]
+		}
+		if len(numbers) > 1 {
+			// One Schema holds the whole history, so the versions are registered in order
+			// and deleting the Schema is the only way to delete the subject
+			manifest.Spec.Schema = ""
+			for _, number := range numbers {
+				manifest.Spec.Versions = append(manifest.Spec.Versions, registryv1alpha1.SchemaVersion{
+					Schema: versions[number].Schema,
+				})
+			}
+		}
+		files = append(files, manifestFile{name: name + ".yaml", objects: []any{manifest}})
+	}
+
+	return files, nil
+}
+
+// schemaRegistryManifest builds the SchemaRegistry the exported Schemas refer to, with the
+// flavor and the Apicurio or Glue settings of the flags. Credentials are never exported;
+// the auth section only references a Secret.
+func schemaRegistryManifest(registry registryFlags, opts exportOptions) (*registryv1alpha1.SchemaRegistry, error) {
+	sr := &registryv1alpha1.SchemaRegistry{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: registryv1alpha1.GroupVersion.String(),
+			Kind:       "SchemaRegistry",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: opts.registryName, Namespace: opts.namespace},
+	}
+	if len(registry.urls) == 1 {
+		sr.Spec.URL = registry.urls[0]
+	} else {
+		sr.Spec.URLs = registry.urls
+	}
+
+	switch registry.flavor {
+	case "", schemaclient.FlavorConfluent:
+	case schemaclient.FlavorApicurioV2, schemaclient.FlavorApicurioV3:
+		sr.Spec.Flavor = registryv1alpha1.RegistryFlavor(registry.flavor)
+		labels, err := registry.apicurioLabelMap()
+		if err != nil {
+			return nil, err
+		}
+		if registry.apicurioGroup != "" || len(labels) > 0 {
+			sr.Spec.Apicurio = &registryv1alpha1.ApicurioConfig{
+				GroupID: registry.apicurioGroup,
+				Labels:  labels,
+			}
+		}
+	case schemaclient.FlavorGlue:
+		sr.Spec.Flavor = registryv1alpha1.RegistryFlavorGlue
+		sr.Spec.Glue = &registryv1alpha1.GlueConfig{
+			Region:       registry.glueRegion,
+			RegistryName: registry.glueRegistryName,
+		}
+		// Glue signs requests with IAM access keys instead of the auth section
+		if os.Getenv("AWS_ACCESS_KEY_ID") != "" {
+			sr.Spec.Glue.CredentialsSecretRef = &registryv1alpha1.AWSCredentialsSecretRef{Name: opts.credentialsSecret}
+		}
+		return sr, nil
+	}
+
+	switch {
+	case registry.username != "":
+		sr.Spec.Auth = &registryv1alpha1.AuthConfig{
+			Type: registryv1alpha1.AuthTypeBasic,
+			BasicAuth: &registryv1alpha1.BasicAuthConfig{
+				SecretRef: registryv1alpha1.BasicAuthSecretRef{Name: opts.credentialsSecret},
+			},
+		}
+	case os.Getenv("SCHEMA_REGISTRY_TOKEN") != "":
+		sr.Spec.Auth = &registryv1alpha1.AuthConfig{
+			Type: registryv1alpha1.AuthTypeBearer,
+			BearerAuth: &registryv1alpha1.BearerAuthConfig{
+				SecretRef: registryv1alpha1.BearerTokenSecretRef{Name: opts.credentialsSecret},
+			},
+		}
+	}
+	return sr, nil
+}
+
+// schemaManifest builds the Schema manifest of one subject version. For several versions
+// the caller replaces spec.schema by spec.versions, keeping the references of this version.
+func schemaManifest(name, subject string, schema *schemaclient.SchemaResponse, opts exportOptions) *registryv1alpha1.Schema {
+	// The registry leaves out the schema type of AVRO schemas
+	schemaType := registryv1alpha1.SchemaType(schema.SchemaType)
+	if schemaType == "" {
+		schemaType = registryv1alpha1.SchemaTypeAvro
+	}
+
+	manifest := &registryv1alpha1.Schema{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: registryv1alpha1.GroupVersion.String(),
+			Kind:       "Schema",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: opts.namespace},
+		Spec: registryv1alpha1.SchemaSpec{
+			Subject:     subject,
+			SchemaType:  schemaType,
+			Schema:      schema.Schema,
+			RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: opts.registryName},
+		},
+	}
+	for _, ref := range schema.References {
+		manifest.Spec.References = append(manifest.Spec.References, registryv1alpha1.SchemaReference{
+			Name:    ref.Name,
+			Subject: ref.Subject,
+			Version: ref.Version,
+		})
+	}
+	return manifest
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// resourceName turns a subject into a valid Kubernetes object name.
+func resourceName(subject string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(subject), "-")
+	// Leave room for the counter added by uniqueName
+	if len(name) > validation.DNS1123SubdomainMaxLength-10 {
+		name = name[:validation.DNS1123SubdomainMaxLength-10]
+	}
+	name = strings.Trim(name, "-.")
+	if name == "" {
+		name = "schema"
+	}
+	return name
+}
+
+// uniqueName appends a counter to name when a different subject already mapped to it.
+func uniqueName(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+	used[candidate] = true
+	return candidate
+}
+
+// writeManifests writes all manifests to w as one multi-document YAML stream.
+func writeManifests(w io.Writer, files []manifestFile) error {
+	for _, file := range files {
+		for _, obj := range file.objects {
+			data, err := yaml.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeManifestDir writes every manifest file and a kustomization.yaml listing them to dir.
+func writeManifestDir(dir string, files []manifestFile) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	kust := kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+	}
+	for _, file := range files {
+		f, err := os.Create(filepath.Join(dir, file.name))
+		if err != nil {
+			return err
+		}
+		err = writeManifests(f, []manifestFile{file})
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		kust.Resources = append(kust.Resources, file.name)
+	}
+
+	data, err := yaml.Marshal(kust)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "kustomization.yaml"), data, 0o644)
+}
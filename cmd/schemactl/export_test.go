@@ -0,0 +1,288 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+// newExportRegistry serves two subjects, where both versions of Order_Events-value reference
+// version 3 of customer-value and Order_Events-value has a subject-level compatibility level.
+// Version 2 of customer-value is soft-deleted.
+func newExportRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/subjects", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`["Order_Events-value","customer-value"]`))
+	})
+	mux.HandleFunc("/subjects/Order_Events-value/versions", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[1,2]`))
+	})
+	mux.HandleFunc("/subjects/Order_Events-value/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"Order_Events-value","id":10,"version":1,"schemaType":"JSON","schema":"{}",` +
+			`"references":[{"name":"customer.json","subject":"customer-value","version":3}]}`))
+	})
+	orderLatest := `{"subject":"Order_Events-value","id":11,"version":2,"schemaType":"JSON","schema":"{\"type\":\"object\"}",` +
+		`"references":[{"name":"customer.json","subject":"customer-value","version":3}]}`
+	mux.HandleFunc("/subjects/Order_Events-value/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(orderLatest))
+	})
+	mux.HandleFunc("/subjects/Order_Events-value/versions/latest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(orderLatest))
+	})
+	mux.HandleFunc("/subjects/customer-value/versions", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[1,3]`))
+	})
+	mux.HandleFunc("/subjects/customer-value/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"customer-value","id":5,"version":1,"schema":"\"string\""}`))
+	})
+	customerLatest := `{"subject":"customer-value","id":7,"version":3,"schema":"\"bytes\""}`
+	mux.HandleFunc("/subjects/customer-value/versions/3", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(customerLatest))
+	})
+	mux.HandleFunc("/subjects/customer-value/versions/latest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(customerLatest))
+	})
+	mux.HandleFunc("/config/Order_Events-value", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"compatibilityLevel":"FULL"}`))
+	})
+	mux.HandleFunc("/config/customer-value", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code":40408,"message":"Subject does not have subject-level compatibility configured"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestExport_FollowsReferences(t *testing.T) {
+	srv := newExportRegistry(t)
+
+	var out bytes.Buffer
+	err := runExport(context.Background(), []string{
+		"--url", srv.URL, "--namespace", "kafka", "--subject", "^Order",
+	}, &out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	docs := strings.Split(strings.TrimPrefix(out.String(), "---\n"), "---\n")
+	if len(docs) != 3 {
+		t.Fatalf("expected SchemaRegistry and two Schemas, got %d documents:\n%s", len(docs), out.String())
+	}
+
+	var sr registryv1alpha1.SchemaRegistry
+	if err := yaml.UnmarshalStrict([]byte(docs[0]), &sr); err != nil {
+		t.Fatalf("failed to decode SchemaRegistry: %v", err)
+	}
+	if sr.Kind != "SchemaRegistry" || sr.Name != "schema-registry" || sr.Spec.URL != srv.URL || sr.Spec.Auth != nil {
+		t.Errorf("unexpected SchemaRegistry: %+v", sr)
+	}
+
+	var order registryv1alpha1.Schema
+	if err := yaml.UnmarshalStrict([]byte(docs[1]), &order); err != nil {
+		t.Fatalf("failed to decode Schema: %v", err)
+	}
+	if order.Name != "order-events-value" || order.Namespace != "kafka" || order.Spec.Subject != "Order_Events-value" {
+		t.Errorf("unexpected metadata: %+v", order.ObjectMeta)
+	}
+	if order.Spec.SchemaType != registryv1alpha1.SchemaTypeJSON || order.Spec.CompatibilityLevel != "FULL" ||
+		order.Spec.RegistryRef.Name != "schema-registry" {
+		t.Errorf("unexpected spec: %+v", order.Spec)
+	}
+	// Version 3 of customer-value is the only exported version, so an empty registry numbers it 1
+	wantRefs := []registryv1alpha1.SchemaReference{{Name: "customer.json", Subject: "customer-value", Version: 1}}
+	if !slices.Equal(order.Spec.References, wantRefs) {
+		t.Errorf("expected references %+v, got %+v", wantRefs, order.Spec.References)
+	}
+
+	var customer registryv1alpha1.Schema
+	if err := yaml.UnmarshalStrict([]byte(docs[2]), &customer); err != nil {
+		t.Fatalf("failed to decode Schema: %v", err)
+	}
+	if customer.Spec.Subject != "customer-value" || customer.Spec.SchemaType != registryv1alpha1.SchemaTypeAvro ||
+		customer.Spec.CompatibilityLevel != "" {
+		t.Errorf("expected the referenced subject to be exported as AVRO without compatibility, got: %+v", customer.Spec)
+	}
+	if customer.Spec.Schema != `"bytes"` || len(customer.Spec.Versions) != 0 {
+		t.Errorf("expected the referenced version instead of the latest, got: %+v", customer.Spec)
+	}
+}
+
+func TestExport_AllVersionsToDirectory(t *testing.T) {
+	srv := newExportRegistry(t)
+	dir := t.TempDir()
+
+	err := runExport(context.Background(), []string{
+		"--url", srv.URL, "--username", "exporter", "--all-versions", "--output-dir", dir,
+	}, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+	if err != nil {
+		t.Fatalf("kustomization.yaml not written: %v", err)
+	}
+	var kust kustomization
+	if err := yaml.Unmarshal(data, &kust); err != nil {
+		t.Fatalf("failed to decode kustomization: %v", err)
+	}
+	want := []string{"schemaregistry.yaml", "order-events-value.yaml", "customer-value.yaml"}
+	if strings.Join(kust.Resources, ",") != strings.Join(want, ",") {
+		t.Errorf("expected resources %v, got %v", want, kust.Resources)
+	}
+
+	data, err = os.ReadFile(filepath.Join(dir, "order-events-value.yaml"))
+	if err != nil {
+		t.Fatalf("subject file not written: %v", err)
+	}
+	var order registryv1alpha1.Schema
+	if err := yaml.UnmarshalStrict(bytes.TrimPrefix(data, []byte("---\n")), &order); err != nil {
+		t.Fatalf("expected one Schema for the subject: %v\n%s", err, data)
+	}
+	if order.Name != "order-events-value" || order.Spec.Schema != "" || order.Spec.CompatibilityLevel != "FULL" {
+		t.Errorf("unexpected Schema: %+v", order)
+	}
+	wantVersions := []registryv1alpha1.SchemaVersion{{Schema: "{}"}, {Schema: `{"type":"object"}`}}
+	if !slices.Equal(order.Spec.Versions, wantVersions) {
+		t.Errorf("expected versions %+v, got %+v", wantVersions, order.Spec.Versions)
+	}
+	// The soft-deleted version 2 of customer-value is not exported, so version 3 becomes 2
+	wantRefs := []registryv1alpha1.SchemaReference{{Name: "customer.json", Subject: "customer-value", Version: 2}}
+	if !slices.Equal(order.Spec.References, wantRefs) {
+		t.Errorf("expected references %+v, got %+v", wantRefs, order.Spec.References)
+	}
+
+	data, err = os.ReadFile(filepath.Join(dir, "customer-value.yaml"))
+	if err != nil {
+		t.Fatalf("subject file not written: %v", err)
+	}
+	var customer registryv1alpha1.Schema
+	if err := yaml.UnmarshalStrict(bytes.TrimPrefix(data, []byte("---\n")), &customer); err != nil {
+		t.Fatalf("expected one Schema for the subject: %v\n%s", err, data)
+	}
+	wantVersions = []registryv1alpha1.SchemaVersion{{Schema: `"string"`}, {Schema: `"bytes"`}}
+	if !slices.Equal(customer.Spec.Versions, wantVersions) {
+		t.Errorf("expected versions %+v without gaps, got %+v", wantVersions, customer.Spec.Versions)
+	}
+
+	data, err = os.ReadFile(filepath.Join(dir, "schemaregistry.yaml"))
+	if err != nil {
+		t.Fatalf("schemaregistry.yaml not written: %v", err)
+	}
+	if !strings.Contains(string(data), "name: schema-registry-credentials") || strings.Contains(string(data), "exporter") {
+		t.Errorf("expected a Secret reference without credentials, got:\n%s", data)
+	}
+}
+
+func TestExport_RefusesHistoryWithDifferentReferences(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/subjects", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`["orders-value"]`))
+	})
+	mux.HandleFunc("/subjects/orders-value/versions", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[1,2]`))
+	})
+	mux.HandleFunc("/subjects/orders-value/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"orders-value","id":1,"version":1,"schemaType":"JSON","schema":"{}"}`))
+	})
+	mux.HandleFunc("/subjects/orders-value/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"orders-value","id":3,"version":2,"schemaType":"JSON","schema":"{}",` +
+			`"references":[{"name":"customer.json","subject":"customer-value","version":1}]}`))
+	})
+	mux.HandleFunc("/subjects/customer-value/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":"customer-value","id":2,"version":1,"schemaType":"JSON","schema":"{}"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	err := runExport(context.Background(), []string{"--url", srv.URL, "--all-versions"}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "subject orders-value: versions 1 and 2 have different references") {
+		t.Errorf("expected the history to be refused, got: %v", err)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	used := map[string]bool{}
+	for subject, want := range map[string]string{
+		"com.example.User-value": "com.example.user-value",
+		"_Weird__Subject_":       "weird-subject",
+		"???":                    "schema",
+	} {
+		if got := uniqueName(resourceName(subject), used); got != want {
+			t.Errorf("resourceName(%q) = %q, want %q", subject, got, want)
+		}
+	}
+	if got := uniqueName(resourceName("weird subject"), used); got != "weird-subject-2" {
+		t.Errorf("expected a suffix for a colliding name, got %q", got)
+	}
+	if !regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]*[a-z0-9])?$`).MatchString(resourceName(strings.Repeat("A_", 200))) {
+		t.Error("expected a valid name for a long subject")
+	}
+}
+
+func TestSchemaRegistryManifest_Flavor(t *testing.T) {
+	opts := exportOptions{registryName: "schema-registry", credentialsSecret: "schema-registry-credentials"}
+
+	apicurio, err := schemaRegistryManifest(registryFlags{
+		urls:           stringList{"https://apicurio.example.com"},
+		flavor:         "ApicurioV3",
+		apicurioGroup:  "payments",
+		apicurioLabels: stringList{"team=payments"},
+	}, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if apicurio.Spec.Flavor != registryv1alpha1.RegistryFlavorApicurioV3 || apicurio.Spec.Apicurio == nil ||
+		apicurio.Spec.Apicurio.GroupID != "payments" || apicurio.Spec.Apicurio.Labels["team"] != "payments" {
+		t.Errorf("expected the Apicurio flavor and settings, got: %+v", apicurio.Spec)
+	}
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	glue, err := schemaRegistryManifest(registryFlags{
+		flavor:           "Glue",
+		glueRegion:       "eu-west-1",
+		glueRegistryName: "payments",
+		username:         "ignored",
+	}, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if glue.Spec.Flavor != registryv1alpha1.RegistryFlavorGlue || glue.Spec.URL != "" || glue.Spec.Auth != nil ||
+		glue.Spec.Glue == nil || glue.Spec.Glue.Region != "eu-west-1" || glue.Spec.Glue.RegistryName != "payments" ||
+		glue.Spec.Glue.CredentialsSecretRef == nil || glue.Spec.Glue.CredentialsSecretRef.Name != "schema-registry-credentials" {
+		t.Errorf("expected the Glue flavor and settings, got: %+v", glue.Spec)
+	}
+
+	if _, err := schemaRegistryManifest(registryFlags{flavor: "ApicurioV2", apicurioLabels: stringList{"team"}}, opts); err == nil {
+		t.Error("expected an error for a label without a value")
+	}
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hamba/avro/v2"
+	yaml "go.yaml.in/yaml/v3"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/controller"
+	webhookv1alpha1 "github.com/honza/schema-strimzi-operator/internal/webhook/v1alpha1"
+)
+
+// diagnostic is a problem found in a manifest.
+type diagnostic struct {
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (d diagnostic) String() string {
+	location := d.File
+	if d.Line > 0 {
+		location += ":" + strconv.Itoa(d.Line)
+	}
+	var b strings.Builder
+	b.WriteString(location + ": ")
+	if d.Kind != "" {
+		b.WriteString(d.Kind + "/" + d.Name + ": ")
+	}
+	if d.Field != "" {
+		b.WriteString(d.Field + ": ")
+	}
+	b.WriteString(d.Message)
+	return b.String()
+}
+
+// manifest is a registry.strimzi.io document loaded from disk.
+type manifest struct {
+	file string
+	// root is the mapping node of the document, used to find the line of a field
+	root   *yaml.Node
+	object interface {
+		GetName() string
+	}
+	kind string
+}
+
+// diagnostic creates a diagnostic located at the given field of the manifest.
+func (m *manifest) diagnostic(path, message string) diagnostic {
+	return diagnostic{
+		File:    m.file,
+		Line:    fieldLine(m.root, path),
+		Kind:    m.kind,
+		Name:    m.object.GetName(),
+		Field:   path,
+		Message: message,
+	}
+}
+
+// subjectSchema is a schema defined by the linted manifests, keyed by its subject.
+type subjectSchema struct {
+	schemaType registryv1alpha1.SchemaType
+	schema     string
+	references []registryv1alpha1.SchemaReference
+}
+
+func runLint(_ context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("lint", "Validate Schema, SchemaRegistry, TopicSchemas, SchemaReplication and SchemaExporter manifests without a cluster.")
+	var output string
+	fs.StringVar(&output, "output", "text", "Output format: text or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if output != "text" && output != "json" {
+		return fmt.Errorf("unsupported --output %q, must be text or json", output)
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("no files or directories given")
+	}
+
+	files, err := collectManifestFiles(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	manifests, diagnostics := loadManifests(files)
+	diagnostics = append(diagnostics, lintManifests(manifests)...)
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].File != diagnostics[j].File {
+			return diagnostics[i].File < diagnostics[j].File
+		}
+		return diagnostics[i].Line < diagnostics[j].Line
+	})
+
+	if output == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if diagnostics == nil {
+			diagnostics = []diagnostic{}
+		}
+		if err := encoder.Encode(diagnostics); err != nil {
+			return err
+		}
+	} else {
+		for _, d := range diagnostics {
+			if _, err := fmt.Fprintln(stdout, d); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%d problem(s) found in %d file(s)", len(diagnostics), len(files))
+	}
+	return nil
+}
+
+// collectManifestFiles expands directories to the YAML files they contain.
+func collectManifestFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if ext := filepath.Ext(file); !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// loadManifests decodes the registry.strimzi.io documents of the files. Documents of
+// other API groups, e.g. a kustomization.yaml, are skipped.
+func loadManifests(files []string) ([]*manifest, []diagnostic) {
+	var manifests []*manifest
+	var diagnostics []diagnostic
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			diagnostics = append(diagnostics, diagnostic{File: file, Message: err.Error()})
+			continue
+		}
+
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		for {
+			var doc yaml.Node
+			if err := decoder.Decode(&doc); err != nil {
+				if !errors.Is(err, io.EOF) {
+					diagnostics = append(diagnostics, diagnostic{File: file, Message: err.Error()})
+				}
+				break
+			}
+			if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+				continue
+			}
+
+			m, d := decodeManifest(file, doc.Content[0])
+			if d != nil {
+				diagnostics = append(diagnostics, *d)
+			} else if m != nil {
+				manifests = append(manifests, m)
+			}
+		}
+	}
+
+	return manifests, diagnostics
+}
+
+// decodeManifest strictly decodes a document into its API type, so unknown fields are reported.
+func decodeManifest(file string, root *yaml.Node) (*manifest, *diagnostic) {
+	apiVersion := scalarValue(root, "apiVersion")
+	kind := scalarValue(root, "kind")
+	if !strings.HasPrefix(apiVersion, registryv1alpha1.GroupVersion.Group+"/") {
+		return nil, nil
+	}
+
+	m := &manifest{file: file, root: root, kind: kind}
+	fail := func(message string) (*manifest, *diagnostic) {
+		return nil, &diagnostic{File: file, Line: root.Line, Kind: kind, Name: metadataName(root), Message: message}
+	}
+	if apiVersion != registryv1alpha1.GroupVersion.String() {
+		return fail(fmt.Sprintf("unsupported apiVersion %s", apiVersion))
+	}
+
+	switch kind {
+	case "Schema":
+		m.object = &registryv1alpha1.Schema{}
+	case "SchemaRegistry":
+		m.object = &registryv1alpha1.SchemaRegistry{}
+	case "TopicSchemas":
+		m.object = &registryv1alpha1.TopicSchemas{}
+	case "SchemaReplication":
+		m.object = &registryv1alpha1.SchemaReplication{}
+	case "SchemaExporter":
+		m.object = &registryv1alpha1.SchemaExporter{}
+	default:
+		return fail(fmt.Sprintf("unknown kind %s", kind))
+	}
+
+	data, err := yaml.Marshal(root)
+	if err != nil {
+		return fail(err.Error())
+	}
+	if err := sigsyaml.UnmarshalStrict(data, m.object); err != nil {
+		d := &diagnostic{File: file, Line: root.Line, Kind: kind, Name: metadataName(root), Message: err.Error()}
+		if match := unknownField.FindStringSubmatch(err.Error()); match != nil {
+			if key := findKey(root, match[1]); key != nil {
+				d.Line = key.Line
+			}
+		}
+		return nil, d
+	}
+	return m, nil
+}
+
+// unknownField extracts the field name from a strict decoding error.
+var unknownField = regexp.MustCompile(`unknown field "(?:[^"]*\.)?([^".]+)"`)
+
+// findKey returns the first mapping key with the given name, searching depth first.
+func findKey(node *yaml.Node, name string) *yaml.Node {
+	for i, child := range node.Content {
+		if node.Kind == yaml.MappingNode && i%2 == 0 && child.Value == name {
+			return child
+		}
+		if key := findKey(child, name); key != nil {
+			return key
+		}
+	}
+	return nil
+}
+
+// lintManifests runs the webhook validation, parses the schemas and checks that
+// schema references resolve to subjects defined in the manifests.
+func lintManifests(manifests []*manifest) []diagnostic {
+	var diagnostics []diagnostic
+
+	// The API server applies the CRD default before the webhook sees the object
+	subjects := map[string]subjectSchema{}
+	for _, m := range manifests {
+		switch obj := m.object.(type) {
+		case *registryv1alpha1.Schema:
+			if obj.Spec.SchemaType == "" {
+				obj.Spec.SchemaType = registryv1alpha1.SchemaTypeAvro
+			}
+			subjects[obj.Spec.Subject] = subjectSchema{obj.Spec.SchemaType, controller.CurrentSchema(obj), obj.Spec.References}
+		case *registryv1alpha1.TopicSchemas:
+			for suffix, def := range map[string]*registryv1alpha1.TopicSchemaDefinition{"key": obj.Spec.Key, "value": obj.Spec.Value} {
+				if def == nil {
+					continue
+				}
+				if def.SchemaType == "" {
+					def.SchemaType = registryv1alpha1.SchemaTypeAvro
+				}
+				subjects[obj.Spec.Topic+"-"+suffix] = subjectSchema{def.SchemaType, def.Schema, def.References}
+			}
+		}
+	}
+
+	for _, m := range manifests {
+		switch obj := m.object.(type) {
+		case *registryv1alpha1.Schema:
+			diagnostics = append(diagnostics, validationDiagnostics(m, webhookv1alpha1.ValidateSchemaSpec(obj))...)
+			def := subjectSchema{obj.Spec.SchemaType, obj.Spec.Schema, obj.Spec.References}
+			diagnostics = append(diagnostics, lintSchema(m, "spec", def, subjects)...)
+			// Every entry of the history shares the references of the spec
+			for i, version := range obj.Spec.Versions {
+				def.schema = version.Schema
+				diagnostics = append(diagnostics, lintSchemaContent(m, fmt.Sprintf("spec.versions[%d].schema", i), def, subjects)...)
+			}
+		case *registryv1alpha1.SchemaRegistry:
+			diagnostics = append(diagnostics, validationDiagnostics(m, webhookv1alpha1.ValidateSchemaRegistrySpec(obj))...)
+		case *registryv1alpha1.TopicSchemas:
+			diagnostics = append(diagnostics, validationDiagnostics(m, webhookv1alpha1.ValidateTopicSchemasSpec(obj))...)
+			if key := obj.Spec.Key; key != nil {
+				def := subjectSchema{key.SchemaType, key.Schema, key.References}
+				diagnostics = append(diagnostics, lintSchema(m, "spec.key", def, subjects)...)
+			}
+			if value := obj.Spec.Value; value != nil {
+				def := subjectSchema{value.SchemaType, value.Schema, value.References}
+				diagnostics = append(diagnostics, lintSchema(m, "spec.value", def, subjects)...)
+			}
+		case *registryv1alpha1.SchemaReplication:
+			diagnostics = append(diagnostics, validationDiagnostics(m, webhookv1alpha1.ValidateSchemaReplicationSpec(obj))...)
+		case *registryv1alpha1.SchemaExporter:
+			diagnostics = append(diagnostics, validationDiagnostics(m, webhookv1alpha1.ValidateSchemaExporterSpec(obj))...)
+		}
+	}
+
+	return diagnostics
+}
+
+// validationDiagnostics turns the field errors of a webhook validation into diagnostics.
+func validationDiagnostics(m *manifest, err error) []diagnostic {
+	if err == nil {
+		return nil
+	}
+	var errs []error
+	var agg utilerrors.Aggregate
+	if errors.As(err, &agg) {
+		errs = agg.Errors()
+	} else {
+		errs = []error{err}
+	}
+
+	diagnostics := make([]diagnostic, 0, len(errs))
+	for _, err := range errs {
+		var fieldErr *field.Error
+		if errors.As(err, &fieldErr) {
+			diagnostics = append(diagnostics, m.diagnostic(fieldErr.Field, fieldErr.ErrorBody()))
+		} else {
+			diagnostics = append(diagnostics, m.diagnostic("", err.Error()))
+		}
+	}
+	return diagnostics
+}
+
+// protobufImport matches the import statements of a .proto file.
+var protobufImport = regexp.MustCompile(`(?m)^\s*import\s+(?:public\s+|weak\s+)?"([^"]+)"\s*;`)
+
+// lintSchema checks that every reference of the schema at path is defined in the
+// manifests and that the schema parses once its references are known.
+func lintSchema(m *manifest, path string, def subjectSchema, subjects map[string]subjectSchema) []diagnostic {
+	var diagnostics []diagnostic
+
+	for i, ref := range def.references {
+		if ref.Subject == "" {
+			continue
+		}
+		if _, ok := subjects[ref.Subject]; !ok {
+			diagnostics = append(diagnostics, m.diagnostic(fmt.Sprintf("%s.references[%d].subject", path, i),
+				fmt.Sprintf("referenced subject %s is not defined in the given files", ref.Subject)))
+		}
+	}
+
+	return append(diagnostics, lintSchemaContent(m, path+".schema", def, subjects)...)
+}
+
+// lintSchemaContent checks that the schema at schemaPath parses once its references are known.
+func lintSchemaContent(m *manifest, schemaPath string, def subjectSchema, subjects map[string]subjectSchema) []diagnostic {
+	var diagnostics []diagnostic
+
+	// Invalid JSON is already reported by the webhook validation
+	if def.schema == "" || (def.schemaType != registryv1alpha1.SchemaTypeProtobuf && !json.Valid([]byte(def.schema))) {
+		return diagnostics
+	}
+
+	switch def.schemaType {
+	case registryv1alpha1.SchemaTypeAvro:
+		// Named types of the references have to be known before the schema is parsed
+		cache := &avro.SchemaCache{}
+		parseAvroReferences(def.references, subjects, cache, map[string]bool{})
+		if _, err := avro.ParseWithCache(def.schema, "", cache); err != nil {
+			diagnostics = append(diagnostics, m.diagnostic(schemaPath, fmt.Sprintf("invalid AVRO schema: %v", err)))
+		}
+	case registryv1alpha1.SchemaTypeJSON:
+		var schema any
+		_ = json.Unmarshal([]byte(def.schema), &schema)
+		switch schema.(type) {
+		case map[string]any, bool:
+		default:
+			diagnostics = append(diagnostics, m.diagnostic(schemaPath, "JSON schema must be an object or a boolean"))
+		}
+	case registryv1alpha1.SchemaTypeProtobuf:
+		names := map[string]bool{}
+		for _, ref := range def.references {
+			names[ref.Name] = true
+		}
+		for _, match := range protobufImport.FindAllStringSubmatch(def.schema, -1) {
+			// Well-known types are built into the registry
+			if !names[match[1]] && !strings.HasPrefix(match[1], "google/protobuf/") {
+				diagnostics = append(diagnostics, m.diagnostic(schemaPath,
+					fmt.Sprintf("import %q has no matching entry in references", match[1])))
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// parseAvroReferences parses the referenced AVRO schemas, depth first, into cache.
+// Errors are ignored here; they are reported on the manifest defining the subject.
+func parseAvroReferences(refs []registryv1alpha1.SchemaReference, subjects map[string]subjectSchema, cache *avro.SchemaCache, seen map[string]bool) {
+	for _, ref := range refs {
+		def, ok := subjects[ref.Subject]
+		if !ok || seen[ref.Subject] || def.schemaType != registryv1alpha1.SchemaTypeAvro {
+			continue
+		}
+		seen[ref.Subject] = true
+		parseAvroReferences(def.references, subjects, cache, seen)
+		_, _ = avro.ParseWithCache(def.schema, "", cache)
+	}
+}
+
+// scalarValue returns the value of a scalar key of a mapping node.
+func scalarValue(node *yaml.Node, key string) string {
+	if value := mappingValue(node, key); value != nil && value.Kind == yaml.ScalarNode {
+		return value.Value
+	}
+	return ""
+}
+
+// metadataName returns metadata.name of a document.
+func metadataName(root *yaml.Node) string {
+	return scalarValue(mappingValue(root, "metadata"), "name")
+}
+
+// mappingValue returns the value node of key in a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// fieldPathSegment matches one segment of a field path, e.g. "references[0]".
+var fieldPathSegment = regexp.MustCompile(`^([^\[]*)((?:\[[^\]]*\])*)$`)
+
+// fieldLine returns the line of the deepest node along a field path such as
+// "spec.references[0].name". Unknown parts of the path fall back to the line of
+// the closest parent that exists.
+func fieldLine(root *yaml.Node, path string) int {
+	line := root.Line
+	node := root
+	if path == "" {
+		return line
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		match := fieldPathSegment.FindStringSubmatch(segment)
+		if match == nil {
+			return line
+		}
+
+		if match[1] != "" {
+			found := false
+			if node.Kind == yaml.MappingNode {
+				for i := 0; i+1 < len(node.Content); i += 2 {
+					if node.Content[i].Value == match[1] {
+						line = node.Content[i].Line
+						node = node.Content[i+1]
+						found = true
+						break
+					}
+				}
+			}
+			if !found {
+				return line
+			}
+		}
+
+		for _, index := range strings.Split(strings.Trim(match[2], "[]"), "][") {
+			if index == "" {
+				continue
+			}
+			i, err := strconv.Atoi(index)
+			if err != nil || node.Kind != yaml.SequenceNode || i >= len(node.Content) {
+				return line
+			}
+			node = node.Content[i]
+			line = node.Line
+		}
+	}
+
+	return line
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const validManifests = `apiVersion: registry.strimzi.io/v1alpha1
+kind: SchemaRegistry
+metadata:
+  name: registry
+spec:
+  url: http://schema-registry:8081
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: customer
+spec:
+  subject: customer-value
+  schema: |
+    {"type": "record", "name": "Customer", "namespace": "com.example", "fields": [{"name": "id", "type": "string"}]}
+  registryRef:
+    name: registry
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: order
+spec:
+  subject: order-value
+  schemaType: AVRO
+  schema: |
+    {"type": "record", "name": "Order", "namespace": "com.example", "fields": [{"name": "customer", "type": "Customer"}]}
+  references:
+    - name: com.example.Customer
+      subject: customer-value
+      version: 1
+  registryRef:
+    name: registry
+`
+
+const invalidManifests = `apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: broken
+spec:
+  subject: broken-value
+  schemaType: AVRO
+  schema: '{"type": "record", "name": "Broken", "fields": [{"name": "id", "type": "strin"}]}'
+  references:
+    - name: com.example.Missing
+      subject: missing-value
+      version: 0
+  registryRef:
+    name: registry
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: SchemaRegistry
+metadata:
+  name: registry
+spec:
+  url: http://schema-registry:8081
+  timeout: 30
+  unknownField: true
+`
+
+func writeManifest(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLint_ValidManifests(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "schemas.yaml", validManifests)
+	writeManifest(t, dir, "kustomization.yaml", "resources:\n  - schemas.yaml\n")
+
+	var out bytes.Buffer
+	if err := runLint(context.Background(), []string{dir}, &out); err != nil {
+		t.Fatalf("unexpected error: %v\n%s", err, out.String())
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no output, got:\n%s", out.String())
+	}
+}
+
+func TestLint_ReportsProblemsWithLines(t *testing.T) {
+	file := writeManifest(t, t.TempDir(), "broken.yaml", invalidManifests)
+
+	var out bytes.Buffer
+	err := runLint(context.Background(), []string{file}, &out)
+	if err == nil {
+		t.Fatalf("expected lint to fail, output:\n%s", out.String())
+	}
+
+	for _, want := range []string{
+		file + ":8: Schema/broken: spec.schema: invalid AVRO schema",
+		file + ":11: Schema/broken: spec.references[0].subject: referenced subject missing-value is not defined",
+		file + ":12: Schema/broken: spec.references[0].version: Invalid value: 0",
+		file + `:23: SchemaRegistry/registry: error unmarshaling JSON: while decoding JSON: json: unknown field "unknownField"`,
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestLint_JSONOutput(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "schemas.yaml", `apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: events
+spec:
+  subject: events-value
+  schemaType: PROTOBUF
+  schema: |
+    syntax = "proto3";
+    import "google/protobuf/timestamp.proto";
+    import "common/header.proto";
+    message Event { common.Header header = 1; }
+  registryRef:
+    name: registry
+`)
+
+	var out bytes.Buffer
+	if err := runLint(context.Background(), []string{"--output", "json", dir}, &out); err == nil {
+		t.Fatal("expected lint to fail")
+	}
+
+	var diagnostics []diagnostic
+	if err := json.Unmarshal(out.Bytes(), &diagnostics); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out.String())
+	}
+	if len(diagnostics) != 1 {
+		t.Fatalf("expected one diagnostic, got: %+v", diagnostics)
+	}
+	d := diagnostics[0]
+	if d.Line != 8 || d.Kind != "Schema" || d.Name != "events" || d.Field != "spec.schema" ||
+		d.Message != `import "common/header.proto" has no matching entry in references` {
+		t.Errorf("unexpected diagnostic: %+v", d)
+	}
+}
+
+func TestLint_ChecksEveryEntryOfVersions(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "schemas.yaml", `apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: events
+spec:
+  subject: events-value
+  schemaType: PROTOBUF
+  versions:
+    - schema: |
+        syntax = "proto3";
+        message Event { string id = 1; }
+    - schema: |
+        syntax = "proto3";
+        import "common/header.proto";
+        message Event { string id = 1; common.Header header = 2; }
+  registryRef:
+    name: registry
+`)
+
+	var out bytes.Buffer
+	if err := runLint(context.Background(), []string{"--output", "json", dir}, &out); err == nil {
+		t.Fatal("expected lint to fail")
+	}
+
+	var diagnostics []diagnostic
+	if err := json.Unmarshal(out.Bytes(), &diagnostics); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out.String())
+	}
+	if len(diagnostics) != 1 || diagnostics[0].Field != "spec.versions[1].schema" {
+		t.Fatalf("expected one diagnostic on spec.versions[1].schema, got: %+v", diagnostics)
+	}
+	if d := diagnostics[0]; d.Line != 12 {
+		t.Errorf("expected the diagnostic on line 12, got: %+v", d)
+	}
+}
+
+func TestLint_ValidatesSchemaReplication(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "replication.yaml", `apiVersion: registry.strimzi.io/v1alpha1
+kind: SchemaReplication
+metadata:
+  name: mirror
+spec:
+  sourceRef:
+    name: registry
+  targetRef:
+    name: registry
+  subjects:
+    include:
+      - "^orders-("
+`)
+
+	var out bytes.Buffer
+	if err := runLint(context.Background(), []string{"--output", "json", dir}, &out); err == nil {
+		t.Fatal("expected lint to fail")
+	}
+
+	var diagnostics []diagnostic
+	if err := json.Unmarshal(out.Bytes(), &diagnostics); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out.String())
+	}
+	fields := map[string]bool{}
+	for _, d := range diagnostics {
+		if d.Kind != "SchemaReplication" || d.Name != "mirror" {
+			t.Errorf("unexpected diagnostic: %+v", d)
+		}
+		fields[d.Field] = true
+	}
+	if !fields["spec.targetRef"] || !fields["spec.subjects.include[0]"] {
+		t.Errorf("expected diagnostics on spec.targetRef and spec.subjects.include[0], got: %+v", diagnostics)
+	}
+}
+
+// The samples cover every kind of the API, so a kind lint does not know fails here.
+func TestLint_ConfigSamples(t *testing.T) {
+	var out bytes.Buffer
+	if err := runLint(context.Background(), []string{filepath.Join("..", "..", "config", "samples")}, &out); err != nil {
+		t.Fatalf("expected the samples to pass lint: %v\n%s", err, out.String())
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command schemactl works with Schema Registry contents and Schema manifests
+// outside of the cluster.
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+)
+
+const usage = `schemactl works with Schema Registry contents and Schema manifests.
+
+Usage:
+  schemactl <command> [flags]
+
+Commands:
+  export    Generate SchemaRegistry and Schema manifests from a live registry
+  lint      Validate Schema, SchemaRegistry, TopicSchemas, SchemaReplication and SchemaExporter manifests without a cluster
+  plan      Show what the operator would change in the registry for the given manifests
+
+Run "schemactl <command> -h" for the flags of a command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	command, args := os.Args[1], os.Args[2:]
+	var err error
+	switch command {
+	case "export":
+		err = runExport(ctx, args, os.Stdout)
+	case "lint":
+		err = runLint(ctx, args, os.Stdout)
+	case "plan":
+		err = runPlan(ctx, args, os.Stdout)
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", command, usage)
+		os.Exit(2)
+	}
+
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "schemactl %s: %v\n", command, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// registryFlags holds the flags used to connect to a Schema Registry.
+// Credentials are read from the environment so they do not end up in shell history.
+type registryFlags struct {
+	urls               stringList
+	flavor             string
+	apicurioGroup      string
+	apicurioLabels     stringList
+	glueRegion         string
+	glueRegistryName   string
+	username           string
+	caFile             string
+	insecureSkipVerify bool
+	timeout            time.Duration
+}
+
+func (f *registryFlags) bind(fs *flag.FlagSet) {
+	fs.Var(&f.urls, "url", "Schema Registry URL (repeatable for several endpoints of the same cluster)")
+	fs.StringVar(&f.flavor, "flavor", schemaclient.FlavorConfluent,
+		"Registry API: Confluent, ApicurioV2, ApicurioV3 or Glue")
+	fs.StringVar(&f.apicurioGroup, "apicurio-group", "", "Artifact group of the Apicurio flavors (default \"default\")")
+	fs.Var(&f.apicurioLabels, "apicurio-label", "Label key=value set on registered Apicurio artifacts (repeatable)")
+	fs.StringVar(&f.glueRegion, "glue-region", "", "AWS region of the Glue flavor, --url defaults to its Glue endpoint")
+	fs.StringVar(&f.glueRegistryName, "glue-registry-name", "", "Glue registry of the Glue flavor (default \"default-registry\")")
+	fs.StringVar(&f.username, "username", "",
+		"Username for basic auth, the password is read from SCHEMA_REGISTRY_PASSWORD")
+	fs.StringVar(&f.caFile, "ca-file", "", "PEM encoded CA bundle used to verify the registry certificate")
+	fs.BoolVar(&f.insecureSkipVerify, "insecure-skip-verify", false, "Skip verification of the registry certificate")
+	fs.DurationVar(&f.timeout, "timeout", 30*time.Second, "Timeout of each request to the registry")
+}
+
+// newClient builds a registry client for the --flavor from the flags. A bearer token is
+// read from SCHEMA_REGISTRY_TOKEN when no username is given. The Glue flavor signs requests
+// with the AWS credentials of the environment.
+func (f *registryFlags) newClient() (schemaclient.Registry, error) {
+	if len(f.urls) == 0 && f.flavor != schemaclient.FlavorGlue {
+		return nil, fmt.Errorf("--url is required")
+	}
+	labels, err := f.apicurioLabelMap()
+	if err != nil {
+		return nil, err
+	}
+
+	auth := schemaclient.AuthConfig{Type: "NONE"}
+	switch {
+	case f.username != "":
+		auth = schemaclient.AuthConfig{
+			Type:     "BASIC",
+			Username: f.username,
+			Password: os.Getenv("SCHEMA_REGISTRY_PASSWORD"),
+		}
+	case os.Getenv("SCHEMA_REGISTRY_TOKEN") != "":
+		auth = schemaclient.AuthConfig{
+			Type:        "BEARER",
+			BearerToken: os.Getenv("SCHEMA_REGISTRY_TOKEN"),
+		}
+	}
+
+	tlsConfig := schemaclient.TLSConfig{InsecureSkipVerify: f.insecureSkipVerify}
+	if f.caFile != "" {
+		pem, err := os.ReadFile(f.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if err := schemaclient.AppendCertsFromPEM(tlsConfig.RootCAs, pem); err != nil {
+			return nil, fmt.Errorf("invalid CA bundle in %s: %w", f.caFile, err)
+		}
+	}
+
+	return schemaclient.New(f.flavor, f.urls, auth, schemaclient.Options{
+		Timeout: f.timeout,
+		TLS:     tlsConfig,
+		Apicurio: schemaclient.ApicurioOptions{
+			GroupID: f.apicurioGroup,
+			Labels:  labels,
+		},
+		Glue: schemaclient.GlueOptions{
+			Region:       f.glueRegion,
+			RegistryName: f.glueRegistryName,
+		},
+	})
+}
+
+// apicurioLabelMap parses the key=value pairs of --apicurio-label.
+func (f *registryFlags) apicurioLabelMap() (map[string]string, error) {
+	if len(f.apicurioLabels) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string, len(f.apicurioLabels))
+	for _, label := range f.apicurioLabels {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --apicurio-label %q, expected key=value", label)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// newFlagSet creates the flag set of a command, printing its usage to stderr.
+func newFlagSet(name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "%s\n\nUsage:\n  schemactl %s [flags]\n\nFlags:\n", synopsis, name)
+		fs.PrintDefaults()
+	}
+	fs.SetOutput(os.Stderr)
+	return fs
+}
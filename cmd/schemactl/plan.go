@@ -0,0 +1,338 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+	"github.com/honza/schema-strimzi-operator/internal/schemadiff"
+)
+
+// planAction is what the operator would do with a subject.
+type planAction string
+
+const (
+	planCreate       planAction = "create"
+	planUpdate       planAction = "update"
+	planIncompatible planAction = "incompatible"
+	planConflict     planAction = "conflict"
+	planUnchanged    planAction = "unchanged"
+	planSkip         planAction = "skip"
+)
+
+// planSymbols are the terraform-style markers printed in front of each action.
+var planSymbols = map[planAction]string{
+	planCreate:       "+",
+	planUpdate:       "~",
+	planIncompatible: "!",
+	planConflict:     "x",
+	planUnchanged:    " ",
+	planSkip:         "-",
+}
+
+// subjectPlan is the planned change of one subject.
+type subjectPlan struct {
+	// source identifies the manifest, e.g. "Schema kafka/orders"
+	source  string
+	subject string
+	action  planAction
+	// summary describes the action, e.g. "new version 3"
+	summary string
+	// diff lists the field-level differences to the latest registered version
+	diff []string
+	// compatibility is "OLD -> NEW" when the subject compatibility level changes
+	compatibility string
+}
+
+// plannedSubject is a subject defined by a manifest, as the operator would register it.
+type plannedSubject struct {
+	source  string
+	subject string
+	// versions are the registrations in order: spec.schema, or each entry of spec.versions
+	versions           []plannedVersion
+	compatibilityLevel string
+	observeOnly        bool
+	suspend            bool
+}
+
+// plannedVersion is one registration of a plannedSubject.
+type plannedVersion struct {
+	// entry is the "versions[N]" label of a spec.versions entry, empty for spec.schema
+	entry   string
+	request schemaclient.RegisterSchemaRequest
+	// version is the version number pinned by the entry, zero when not pinned
+	version int
+}
+
+func runPlan(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("plan", "Show what the operator would change in the registry for the given manifests.")
+	var registry registryFlags
+	registry.bind(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("no files or directories given")
+	}
+
+	files, err := collectManifestFiles(fs.Args())
+	if err != nil {
+		return err
+	}
+	manifests, diagnostics := loadManifests(files)
+	if len(diagnostics) > 0 {
+		for _, d := range diagnostics {
+			fmt.Fprintln(stdout, d)
+		}
+		return fmt.Errorf("%d manifest(s) could not be loaded, run schemactl lint for details", len(diagnostics))
+	}
+
+	srClient, err := registry.newClient()
+	if err != nil {
+		return err
+	}
+
+	var plans []subjectPlan
+	for _, subject := range plannedSubjects(manifests) {
+		subjectPlans, err := planSubject(ctx, srClient, subject)
+		if err != nil {
+			return fmt.Errorf("%s: %w", subject.source, err)
+		}
+		plans = append(plans, subjectPlans...)
+	}
+
+	counts := printPlan(stdout, plans)
+	var problems []string
+	if counts[planIncompatible] > 0 {
+		problems = append(problems, fmt.Sprintf("%d subject(s) would be rejected as incompatible", counts[planIncompatible]))
+	}
+	if counts[planConflict] > 0 {
+		problems = append(problems, fmt.Sprintf("%d version(s) conflict with the pinned version", counts[planConflict]))
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// plannedSubjects lists the subjects registered by Schema and TopicSchemas manifests.
+func plannedSubjects(manifests []*manifest) []plannedSubject {
+	var subjects []plannedSubject
+	for _, m := range manifests {
+		switch obj := m.object.(type) {
+		case *registryv1alpha1.Schema:
+			subject := plannedSubject{
+				source:             fmt.Sprintf("Schema %s", objectName(obj.Namespace, obj.Name)),
+				subject:            obj.Spec.Subject,
+				compatibilityLevel: obj.Spec.CompatibilityLevel,
+				observeOnly:        obj.Spec.ObserveOnly,
+				suspend:            obj.Spec.Suspend,
+			}
+			if len(obj.Spec.Versions) == 0 {
+				subject.versions = []plannedVersion{{
+					request: registerRequest(obj.Spec.SchemaType, obj.Spec.Schema, obj.Spec.References),
+				}}
+			}
+			for i, entry := range obj.Spec.Versions {
+				subject.versions = append(subject.versions, plannedVersion{
+					entry:   fmt.Sprintf("versions[%d]", i),
+					request: registerRequest(obj.Spec.SchemaType, entry.Schema, obj.Spec.References),
+					version: entry.Version,
+				})
+			}
+			subjects = append(subjects, subject)
+		case *registryv1alpha1.TopicSchemas:
+			for _, part := range []struct {
+				suffix string
+				def    *registryv1alpha1.TopicSchemaDefinition
+			}{{"key", obj.Spec.Key}, {"value", obj.Spec.Value}} {
+				if part.def == nil {
+					continue
+				}
+				subjects = append(subjects, plannedSubject{
+					source:  fmt.Sprintf("TopicSchemas %s (%s)", objectName(obj.Namespace, obj.Name), part.suffix),
+					subject: obj.Spec.Topic + "-" + part.suffix,
+					versions: []plannedVersion{{
+						request: registerRequest(part.def.SchemaType, part.def.Schema, part.def.References),
+					}},
+					compatibilityLevel: part.def.CompatibilityLevel,
+				})
+			}
+		}
+	}
+	return subjects
+}
+
+// registerRequest builds the request the controller sends, applying the CRD default schema type.
+func registerRequest(schemaType registryv1alpha1.SchemaType, schema string, refs []registryv1alpha1.SchemaReference) schemaclient.RegisterSchemaRequest {
+	if schemaType == "" {
+		schemaType = registryv1alpha1.SchemaTypeAvro
+	}
+	request := schemaclient.RegisterSchemaRequest{Schema: schema, SchemaType: string(schemaType)}
+	for _, ref := range refs {
+		request.References = append(request.References, schemaclient.SchemaReference{
+			Name:    ref.Name,
+			Subject: ref.Subject,
+			Version: ref.Version,
+		})
+	}
+	return request
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// planSubject works out the change to one subject using only read endpoints:
+// the schema lookup, the compatibility check and the subject configuration.
+// It returns one plan per registration, in order. Like the controller, the first
+// registration that would fail blocks the later entries of spec.versions.
+func planSubject(ctx context.Context, srClient schemaclient.Registry, subject plannedSubject) ([]subjectPlan, error) {
+	if subject.suspend {
+		return []subjectPlan{{source: subject.source, subject: subject.subject, action: planSkip, summary: "suspended"}}, nil
+	}
+
+	var plans []subjectPlan
+	// next is the version the registry would assign to the next new schema, zero until known
+	next := 0
+	// pending is the schema of an earlier entry that would be registered first, if any
+	pending := ""
+	blocked := ""
+	for _, version := range subject.versions {
+		plan := subjectPlan{source: subject.source, subject: subject.subject}
+		if version.entry != "" {
+			plan.source += " " + version.entry
+		}
+		if blocked != "" {
+			plan.action, plan.summary = planSkip, "blocked by "+blocked
+			plans = append(plans, plan)
+			continue
+		}
+
+		existing, err := srClient.LookupSchema(ctx, subject.subject, version.request)
+		switch {
+		case err == nil:
+			plan.action, plan.summary = planUnchanged, fmt.Sprintf("matches version %d (ID %d)", existing.Version, existing.ID)
+			if version.version != 0 && existing.Version != version.version {
+				plan.action, plan.summary = planConflict,
+					fmt.Sprintf("registered as version %d instead of the pinned version %d", existing.Version, version.version)
+			}
+		case schemaclient.IsSubjectNotFound(err):
+			if subject.observeOnly {
+				plan.action, plan.summary = planSkip, "observe-only, subject not found"
+				break
+			}
+			plan.action, plan.summary = planCreate, "new subject"
+			if next == 0 {
+				next = 1
+			}
+			if pending != "" {
+				plan.summary = fmt.Sprintf("new version %d", next)
+				plan.diff = schemadiff.Diff(version.request.SchemaType, pending, version.request.Schema)
+			}
+		case schemaclient.IsSchemaNotFound(err):
+			if subject.observeOnly {
+				plan.action, plan.summary = planSkip, "observe-only, no version matches"
+				break
+			}
+			plan.action = planUpdate
+			base := pending
+			if pending == "" {
+				latest, err := srClient.GetSchema(ctx, subject.subject, "latest")
+				if err != nil {
+					return nil, err
+				}
+				next, base = latest.Version+1, latest.Schema
+				// The registry checks against its latest version, not against a pending entry
+				compatible, err := srClient.CheckCompatibility(ctx, subject.subject, version.request)
+				if err != nil {
+					return nil, err
+				}
+				if !compatible {
+					plan.action, plan.summary = planIncompatible, fmt.Sprintf("incompatible with version %d", latest.Version)
+				}
+			}
+			if plan.action == planUpdate {
+				plan.summary = fmt.Sprintf("new version %d", next)
+			}
+			plan.diff = schemadiff.Diff(version.request.SchemaType, base, version.request.Schema)
+		default:
+			return nil, err
+		}
+
+		if plan.action == planCreate || plan.action == planUpdate {
+			if version.version != 0 && next != version.version {
+				plan.action, plan.summary = planConflict,
+					fmt.Sprintf("registry would assign version %d instead of the pinned version %d", next, version.version)
+			} else {
+				pending = version.request.Schema
+				next++
+			}
+		}
+		if plan.action == planIncompatible || plan.action == planConflict {
+			blocked = version.entry
+		}
+		plans = append(plans, plan)
+	}
+
+	// Observe-only schemas never change the subject configuration
+	if subject.compatibilityLevel == "" || subject.observeOnly {
+		return plans, nil
+	}
+	current, err := srClient.GetSubjectCompatibility(ctx, subject.subject)
+	if err != nil {
+		return nil, err
+	}
+	if current != subject.compatibilityLevel {
+		if current == "" {
+			current = "(global)"
+		}
+		// The controller applies the compatibility level of spec.versions before the entries
+		plans[0].compatibility = current + " -> " + subject.compatibilityLevel
+		if plans[0].action == planUnchanged {
+			plans[0].action = planUpdate
+		}
+	}
+	return plans, nil
+}
+
+// printPlan prints the plan and returns the number of plans of each action.
+func printPlan(w io.Writer, plans []subjectPlan) map[planAction]int {
+	counts := map[planAction]int{}
+	for _, plan := range plans {
+		counts[plan.action]++
+		fmt.Fprintf(w, "%s %s: subject %s, %s\n", planSymbols[plan.action], plan.source, plan.subject, plan.summary)
+		for _, line := range plan.diff {
+			fmt.Fprintf(w, "      %s\n", line)
+		}
+		if plan.compatibility != "" {
+			fmt.Fprintf(w, "    ~ compatibilityLevel: %s\n", plan.compatibility)
+		}
+	}
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d incompatible, %d conflicting, %d unchanged, %d skipped.\n",
+		counts[planCreate], counts[planUpdate], counts[planIncompatible], counts[planConflict], counts[planUnchanged], counts[planSkip])
+	return counts
+}
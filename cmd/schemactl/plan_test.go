@@ -0,0 +1,228 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const planManifests = `apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: orders
+  namespace: kafka
+spec:
+  subject: orders-value
+  schema: |
+    {"type": "record", "name": "Order", "fields": [
+      {"name": "id", "type": "string"},
+      {"name": "amount", "type": "long"},
+      {"name": "note", "type": ["null", "string"], "default": null}
+    ]}
+  registryRef:
+    name: registry
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: users
+  namespace: kafka
+spec:
+  subject: users-value
+  schema: '"string"'
+  compatibilityLevel: NONE
+  registryRef:
+    name: registry
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: payments
+  namespace: kafka
+spec:
+  subject: payments-value
+  schema: '"long"'
+  registryRef:
+    name: registry
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: customers
+  namespace: kafka
+spec:
+  subject: customers-value
+  schema: '"string"'
+  compatibilityLevel: FULL
+  registryRef:
+    name: registry
+`
+
+func TestPlan(t *testing.T) {
+	var writes int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		switch route {
+		case "POST /subjects/orders-value", "POST /subjects/payments-value":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40403,"message":"Schema not found"}`))
+		case "POST /subjects/users-value":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40401,"message":"Subject not found"}`))
+		case "POST /subjects/customers-value":
+			_, _ = w.Write([]byte(`{"subject":"customers-value","id":3,"version":1,"schema":"\"string\""}`))
+		case "GET /subjects/orders-value/versions/latest":
+			_, _ = w.Write([]byte(`{"subject":"orders-value","id":7,"version":2,"schema":` +
+				`"{\"type\":\"record\",\"name\":\"Order\",\"fields\":[{\"name\":\"id\",\"type\":\"string\"},{\"name\":\"amount\",\"type\":\"int\"}]}"}`))
+		case "GET /subjects/payments-value/versions/latest":
+			_, _ = w.Write([]byte(`{"subject":"payments-value","id":8,"version":1,"schema":"\"string\""}`))
+		case "POST /compatibility/subjects/orders-value/versions/latest":
+			_, _ = w.Write([]byte(`{"is_compatible":true}`))
+		case "POST /compatibility/subjects/payments-value/versions/latest":
+			_, _ = w.Write([]byte(`{"is_compatible":false}`))
+		case "GET /config/users-value":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40401,"message":"Subject not found"}`))
+		case "GET /config/customers-value":
+			_, _ = w.Write([]byte(`{"compatibilityLevel":"BACKWARD"}`))
+		default:
+			writes++
+			t.Errorf("unexpected request %s", route)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	file := writeManifest(t, t.TempDir(), "schemas.yaml", planManifests)
+
+	var out bytes.Buffer
+	err := runPlan(context.Background(), []string{"--url", srv.URL, file}, &out)
+	if err == nil || !strings.Contains(err.Error(), "1 subject(s) would be rejected as incompatible") {
+		t.Fatalf("expected the incompatible subject to fail the plan, got: %v", err)
+	}
+
+	want := `~ Schema kafka/orders: subject orders-value, new version 3
+      ~ fields[amount].type: "int" -> "long"
+      + fields[note]: {"default":null,"name":"note","type":["null","string"]}
++ Schema kafka/users: subject users-value, new subject
+    ~ compatibilityLevel: (global) -> NONE
+! Schema kafka/payments: subject payments-value, incompatible with version 1
+      ~ (schema): "string" -> "long"
+~ Schema kafka/customers: subject customers-value, matches version 1 (ID 3)
+    ~ compatibilityLevel: BACKWARD -> FULL
+
+Plan: 1 to create, 2 to update, 1 incompatible, 0 conflicting, 0 unchanged, 0 skipped.
+`
+	if out.String() != want {
+		t.Errorf("unexpected plan:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if writes != 0 {
+		t.Errorf("expected no other requests, got %d", writes)
+	}
+}
+
+const planVersionsManifests = `apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: orders
+  namespace: kafka
+spec:
+  subject: orders-value
+  versions:
+  - schema: '"int"'
+    version: 1
+  - schema: '"long"'
+    version: 2
+  - schema: '"double"'
+    version: 3
+  registryRef:
+    name: registry
+---
+apiVersion: registry.strimzi.io/v1alpha1
+kind: Schema
+metadata:
+  name: users
+  namespace: kafka
+spec:
+  subject: users-value
+  versions:
+  - schema: '"int"'
+    version: 1
+  - schema: '"long"'
+    version: 3
+  - schema: '"double"'
+  registryRef:
+    name: registry
+`
+
+func TestPlan_Versions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		var body struct {
+			Schema string `json:"schema"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case route == "POST /subjects/orders-value" && body.Schema == `"int"`:
+			_, _ = w.Write([]byte(`{"subject":"orders-value","id":1,"version":1,"schema":"\"int\""}`))
+		case route == "POST /subjects/orders-value":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40403,"message":"Schema not found"}`))
+		case route == "GET /subjects/orders-value/versions/latest":
+			_, _ = w.Write([]byte(`{"subject":"orders-value","id":1,"version":1,"schema":"\"int\""}`))
+		case route == "POST /compatibility/subjects/orders-value/versions/latest":
+			_, _ = w.Write([]byte(`{"is_compatible":true}`))
+		case route == "POST /subjects/users-value":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40401,"message":"Subject not found"}`))
+		default:
+			t.Errorf("unexpected request %s", route)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	file := writeManifest(t, t.TempDir(), "schemas.yaml", planVersionsManifests)
+
+	var out bytes.Buffer
+	err := runPlan(context.Background(), []string{"--url", srv.URL, file}, &out)
+	if err == nil || !strings.Contains(err.Error(), "1 version(s) conflict with the pinned version") {
+		t.Fatalf("expected the pinned version conflict to fail the plan, got: %v", err)
+	}
+
+	want := `  Schema kafka/orders versions[0]: subject orders-value, matches version 1 (ID 1)
+~ Schema kafka/orders versions[1]: subject orders-value, new version 2
+      ~ (schema): "int" -> "long"
+~ Schema kafka/orders versions[2]: subject orders-value, new version 3
+      ~ (schema): "long" -> "double"
++ Schema kafka/users versions[0]: subject users-value, new subject
+x Schema kafka/users versions[1]: subject users-value, registry would assign version 2 instead of the pinned version 3
+      ~ (schema): "int" -> "long"
+- Schema kafka/users versions[2]: subject users-value, blocked by versions[1]
+
+Plan: 1 to create, 2 to update, 0 incompatible, 1 conflicting, 1 unchanged, 1 skipped.
+`
+	if out.String() != want {
+		t.Errorf("unexpected plan:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,642 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// ApicurioOptions configures the native Apicurio Registry API.
+type ApicurioOptions struct {
+	// GroupID is the artifact group subjects are mapped to, "default" when empty
+	GroupID string
+	// Labels are set on the artifacts registered by the client
+	Labels map[string]string
+}
+
+// ApicurioClient is an HTTP client for the native REST API of Apicurio Registry 2.x and 3.x.
+// A subject maps to the artifact with the same ID in the configured group, a version to the
+// artifact version, a schema ID to the content ID and a compatibility level to the
+// COMPATIBILITY rule of the artifact.
+type ApicurioClient struct {
+	*endpoints
+	apiVersion int
+	basePath   string
+	group      string
+	labels     map[string]string
+}
+
+// apicurioErrorCodes maps Apicurio exception names to the Confluent error codes
+// checked by IsSubjectNotFound and IsSchemaNotFound.
+var apicurioErrorCodes = map[string]int{
+	"ArtifactNotFoundException": 40401,
+	"GroupNotFoundException":    40401,
+	"VersionNotFoundException":  40402,
+	"ContentNotFoundException":  40403,
+}
+
+// apicurioVersionMeta is the version metadata returned by both API versions.
+type apicurioVersionMeta struct {
+	Version   string `json:"version"`
+	GlobalID  int64  `json:"globalId"`
+	ContentID int    `json:"contentId"`
+	// Type is set by the 2.x API, ArtifactType by the 3.x API
+	Type         string `json:"type"`
+	ArtifactType string `json:"artifactType"`
+}
+
+type apicurioReference struct {
+	GroupID    string `json:"groupId"`
+	ArtifactID string `json:"artifactId"`
+	Version    string `json:"version"`
+	Name       string `json:"name"`
+}
+
+// NewApicurioClient creates a client for the native API of Apicurio Registry, apiVersion 2 or 3.
+// The base URLs point at the registry root, e.g. http://apicurio:8080.
+func NewApicurioClient(apiVersion int, baseURLs []string, auth AuthConfig, opts Options) (*ApicurioClient, error) {
+	if apiVersion != 2 && apiVersion != 3 {
+		return nil, fmt.Errorf("unsupported Apicurio Registry API version %d", apiVersion)
+	}
+	e, err := newEndpoints(baseURLs, auth, opts)
+	if err != nil {
+		return nil, err
+	}
+	group := opts.Apicurio.GroupID
+	if group == "" {
+		group = "default"
+	}
+	return &ApicurioClient{
+		endpoints:  e,
+		apiVersion: apiVersion,
+		basePath:   fmt.Sprintf("/apis/registry/v%d", apiVersion),
+		group:      group,
+		labels:     opts.Apicurio.Labels,
+	}, nil
+}
+
+// HealthCheck verifies connectivity by reading the system info.
+// It succeeds when at least one endpoint responds.
+func (c *ApicurioClient) HealthCheck(ctx context.Context) error {
+	resp, err := c.send(ctx, http.MethodGet, c.basePath+"/system/info", nil, nil)
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check failed with status: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// CheckEndpoints reads the system info of every endpoint individually, without failover.
+func (c *ApicurioClient) CheckEndpoints(ctx context.Context) []EndpointHealth {
+	return c.checkEndpoints(ctx, c.basePath+"/system/info")
+}
+
+// ListSubjects returns the IDs of all artifacts in the group.
+func (c *ApicurioClient) ListSubjects(ctx context.Context) ([]string, error) {
+	const pageSize = 500
+	var subjects []string
+	for offset := 0; ; offset += pageSize {
+		var page struct {
+			Artifacts []struct {
+				ID         string `json:"id"`
+				ArtifactID string `json:"artifactId"`
+			} `json:"artifacts"`
+			Count int `json:"count"`
+		}
+		path := fmt.Sprintf("%s/groups/%s/artifacts?limit=%d&offset=%d", c.basePath, url.PathEscape(c.group), pageSize, offset)
+		found, err := c.getJSON(ctx, path, &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subjects: %w", err)
+		}
+		if !found {
+			// The group does not exist until the first artifact is created in it
+			return []string{}, nil
+		}
+		for _, artifact := range page.Artifacts {
+			if c.apiVersion == 2 {
+				subjects = append(subjects, artifact.ID)
+			} else {
+				subjects = append(subjects, artifact.ArtifactID)
+			}
+		}
+		if len(page.Artifacts) < pageSize || len(subjects) >= page.Count {
+			return subjects, nil
+		}
+	}
+}
+
+// GetGlobalCompatibility returns the global COMPATIBILITY rule, NONE when no rule is configured.
+func (c *ApicurioClient) GetGlobalCompatibility(ctx context.Context) (string, error) {
+	level, err := c.getRule(ctx, c.basePath+"/admin/rules/COMPATIBILITY")
+	if err != nil {
+		return "", fmt.Errorf("failed to get global compatibility: %w", err)
+	}
+	if level == "" {
+		return "NONE", nil
+	}
+	return level, nil
+}
+
+// DetectFlavor returns the configured Apicurio API version without a request.
+func (c *ApicurioClient) DetectFlavor(context.Context) (string, error) {
+	if c.apiVersion == 3 {
+		return FlavorApicurioV3, nil
+	}
+	return FlavorApicurioV2, nil
+}
+
+// GetMode returns an empty string, Apicurio Registry has no registry modes.
+func (c *ApicurioClient) GetMode(context.Context) (string, error) {
+	return "", nil
+}
+
+// SetMode fails, Apicurio Registry has no registry modes.
+func (c *ApicurioClient) SetMode(context.Context, string) error {
+	return fmt.Errorf("registry modes are not supported by Apicurio Registry: %w", errors.ErrUnsupported)
+}
+
+// GetSubjectMode returns an empty string, Apicurio Registry has no registry modes.
+func (c *ApicurioClient) GetSubjectMode(context.Context, string) (string, error) {
+	return "", nil
+}
+
+// SetSubjectMode fails, Apicurio Registry has no registry modes.
+func (c *ApicurioClient) SetSubjectMode(context.Context, string, string) error {
+	return fmt.Errorf("registry modes are not supported by Apicurio Registry: %w", errors.ErrUnsupported)
+}
+
+// DeleteSubjectMode does nothing, Apicurio Registry has no registry modes.
+func (c *ApicurioClient) DeleteSubjectMode(context.Context, string) error {
+	return nil
+}
+
+// GetSchemaTypes returns the artifact types supported by the registry.
+func (c *ApicurioClient) GetSchemaTypes(ctx context.Context) ([]string, error) {
+	path := c.basePath + "/admin/artifactTypes"
+	if c.apiVersion == 3 {
+		path = c.basePath + "/admin/config/artifactTypes"
+	}
+	var artifactTypes []struct {
+		Name string `json:"name"`
+	}
+	if _, err := c.getJSON(ctx, path, &artifactTypes); err != nil {
+		return nil, fmt.Errorf("failed to get schema types: %w", err)
+	}
+	types := make([]string, 0, len(artifactTypes))
+	for _, t := range artifactTypes {
+		types = append(types, t.Name)
+	}
+	return types, nil
+}
+
+// GetServerVersion returns the registry version from the system info.
+func (c *ApicurioClient) GetServerVersion(ctx context.Context) (*ServerVersion, error) {
+	var info struct {
+		Version string `json:"version"`
+	}
+	found, err := c.getJSON(ctx, c.basePath+"/system/info", &info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return &ServerVersion{Version: info.Version}, nil
+}
+
+// GetSubjectVersions returns the version numbers of the artifact in ascending order.
+func (c *ApicurioClient) GetSubjectVersions(ctx context.Context, subject string) ([]int, error) {
+	var result struct {
+		Versions []apicurioVersionMeta `json:"versions"`
+	}
+	found, err := c.getJSON(ctx, c.artifactPath(subject)+"/versions?limit=1000", &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subject versions: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("subject %s not found", subject)
+	}
+	versions := make([]int, 0, len(result.Versions))
+	for _, v := range result.Versions {
+		version, err := strconv.Atoi(v.Version)
+		if err != nil {
+			return nil, fmt.Errorf("artifact %s has non-numeric version %q", subject, v.Version)
+		}
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions, nil
+}
+
+// GetSchema returns one version of the artifact with its content and references.
+// version is a version number or "latest".
+func (c *ApicurioClient) GetSchema(ctx context.Context, subject, version string) (*SchemaResponse, error) {
+	metaPath := c.artifactPath(subject) + "/versions/" + url.PathEscape(version)
+	switch {
+	case version == "latest" && c.apiVersion == 2:
+		metaPath = c.artifactPath(subject) + "/meta"
+	case version == "latest":
+		metaPath = c.artifactPath(subject) + "/versions/branch=latest"
+	case c.apiVersion == 2:
+		metaPath += "/meta"
+	}
+
+	var meta apicurioVersionMeta
+	found, err := c.getJSON(ctx, metaPath, &meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("version %s of subject %s not found", version, subject)
+	}
+
+	versionPath := c.artifactPath(subject) + "/versions/" + url.PathEscape(meta.Version)
+	contentPath := versionPath
+	if c.apiVersion == 3 {
+		contentPath += "/content"
+	}
+	content, err := c.getContent(ctx, contentPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	var refs []apicurioReference
+	if _, err := c.getJSON(ctx, versionPath+"/references", &refs); err != nil {
+		return nil, fmt.Errorf("failed to get schema references: %w", err)
+	}
+
+	result := &SchemaResponse{
+		Subject:    subject,
+		ID:         meta.ContentID,
+		SchemaType: meta.schemaType(),
+		Schema:     content,
+	}
+	if result.Version, err = strconv.Atoi(meta.Version); err != nil {
+		return nil, fmt.Errorf("artifact %s has non-numeric version %q", subject, meta.Version)
+	}
+	for _, ref := range refs {
+		refVersion, _ := strconv.Atoi(ref.Version)
+		result.References = append(result.References, SchemaReference{Name: ref.Name, Subject: ref.ArtifactID, Version: refVersion})
+	}
+	return result, nil
+}
+
+// GetSubjectCompatibility returns the COMPATIBILITY rule of the artifact, or an
+// empty string when the artifact inherits the global rule.
+func (c *ApicurioClient) GetSubjectCompatibility(ctx context.Context, subject string) (string, error) {
+	level, err := c.getRule(ctx, c.artifactPath(subject)+"/rules/COMPATIBILITY")
+	if err != nil {
+		return "", fmt.Errorf("failed to get subject compatibility: %w", err)
+	}
+	return level, nil
+}
+
+// RegisterSchema creates the artifact or adds a version to it. Content identical to an
+// existing version returns that version, matching the idempotent Confluent registration.
+func (c *ApicurioClient) RegisterSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
+	var (
+		path   string
+		body   any
+		header http.Header
+	)
+	if c.apiVersion == 2 {
+		path = fmt.Sprintf("%s/groups/%s/artifacts?ifExists=RETURN_OR_UPDATE&canonical=true", c.basePath, url.PathEscape(c.group))
+		body = map[string]any{"content": request.Schema, "references": c.references(request.References)}
+		header = http.Header{
+			"Content-Type":            {"application/create.extended+json"},
+			"X-Registry-ArtifactId":   {subject},
+			"X-Registry-ArtifactType": {artifactType(request.SchemaType)},
+		}
+	} else {
+		path = fmt.Sprintf("%s/groups/%s/artifacts?ifExists=FIND_OR_CREATE_VERSION&canonical=true", c.basePath, url.PathEscape(c.group))
+		body = map[string]any{
+			"artifactId":   subject,
+			"artifactType": artifactType(request.SchemaType),
+			"labels":       c.labels,
+			"firstVersion": map[string]any{"content": c.versionContent(request)},
+		}
+	}
+
+	// The 2.x API answers with the version metadata, the 3.x API wraps it in "version"
+	var meta apicurioVersionMeta
+	var out any = &meta
+	var created struct {
+		Version *apicurioVersionMeta `json:"version"`
+	}
+	if c.apiVersion == 3 {
+		created.Version = &meta
+		out = &created
+	}
+	if err := c.sendJSON(ctx, http.MethodPost, path, body, header, out, "schema registration"); err != nil {
+		return nil, err
+	}
+
+	if c.apiVersion == 2 && len(c.labels) > 0 {
+		// The 2.x API only accepts properties through the artifact metadata
+		if err := c.sendJSON(ctx, http.MethodPut, c.artifactPath(subject)+"/meta",
+			map[string]any{"properties": c.labels}, nil, nil, "artifact metadata update"); err != nil {
+			return nil, err
+		}
+	}
+
+	version, _ := strconv.Atoi(meta.Version)
+	return &SchemaResponse{ID: meta.ContentID, Version: version}, nil
+}
+
+// LookupSchema finds the version of the artifact holding the schema, without registering anything.
+// A missing artifact or version is returned as an APIError, see IsSubjectNotFound and IsSchemaNotFound.
+func (c *ApicurioClient) LookupSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
+	var artifact json.RawMessage
+	found, err := c.getJSON(ctx, c.artifactPath(subject)+c.artifactMetaSuffix(), &artifact)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up schema: %w", err)
+	}
+	if !found {
+		return nil, &APIError{Operation: "schema lookup", StatusCode: http.StatusNotFound, ErrorCode: 40401,
+			Body: fmt.Sprintf("artifact %s not found in group %s", subject, c.group)}
+	}
+
+	var meta *apicurioVersionMeta
+	if c.apiVersion == 2 {
+		var result apicurioVersionMeta
+		header := http.Header{"Content-Type": {"application/get.extended+json"}}
+		body := map[string]any{"content": request.Schema, "references": c.references(request.References)}
+		err := c.sendJSON(ctx, http.MethodPost, c.artifactPath(subject)+"/meta?canonical=true", body, header, &result, "schema lookup")
+		var apiErr *APIError
+		if err != nil && !(errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			meta = &result
+		}
+	} else {
+		var result struct {
+			Versions []apicurioVersionMeta `json:"versions"`
+		}
+		path := fmt.Sprintf("%s/search/versions?groupId=%s&artifactId=%s&canonical=true&artifactType=%s",
+			c.basePath, url.QueryEscape(c.group), url.QueryEscape(subject), artifactType(request.SchemaType))
+		header := http.Header{"Content-Type": {contentType(request.SchemaType)}}
+		if err := c.sendRaw(ctx, http.MethodPost, path, []byte(request.Schema), header, &result, "schema lookup"); err != nil {
+			return nil, err
+		}
+		if len(result.Versions) > 0 {
+			meta = &result.Versions[len(result.Versions)-1]
+		}
+	}
+	if meta == nil {
+		return nil, &APIError{Operation: "schema lookup", StatusCode: http.StatusNotFound, ErrorCode: 40403,
+			Body: fmt.Sprintf("no version of artifact %s holds the schema", subject)}
+	}
+
+	version, _ := strconv.Atoi(meta.Version)
+	return &SchemaResponse{
+		Subject:    subject,
+		ID:         meta.ContentID,
+		Version:    version,
+		SchemaType: meta.schemaType(),
+		Schema:     request.Schema,
+		References: request.References,
+	}, nil
+}
+
+// DeleteSubject deletes the artifact with all its versions.
+func (c *ApicurioClient) DeleteSubject(ctx context.Context, subject string) error {
+	resp, err := c.send(ctx, http.MethodDelete, c.artifactPath(subject), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete subject: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 404 means already gone, which is fine for idempotent cleanup
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(resp.Body)
+		return newApicurioError("delete subject", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// SetCompatibility updates the COMPATIBILITY rule of the artifact, creating it when missing.
+func (c *ApicurioClient) SetCompatibility(ctx context.Context, subject, level string) error {
+	typeField := "type"
+	if c.apiVersion == 3 {
+		typeField = "ruleType"
+	}
+	rule := map[string]string{typeField: "COMPATIBILITY", "config": level}
+
+	err := c.sendJSON(ctx, http.MethodPut, c.artifactPath(subject)+"/rules/COMPATIBILITY", rule, nil, nil, "set compatibility")
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		err = c.sendJSON(ctx, http.MethodPost, c.artifactPath(subject)+"/rules", rule, nil, nil, "set compatibility")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set compatibility: %w", err)
+	}
+	return nil
+}
+
+// CheckCompatibility tests the schema against the rules of the artifact without
+// creating a version. A missing artifact is reported as compatible.
+func (c *ApicurioClient) CheckCompatibility(ctx context.Context, subject string, request RegisterSchemaRequest) (bool, error) {
+	var err error
+	if c.apiVersion == 2 {
+		header := http.Header{"Content-Type": {contentType(request.SchemaType)}}
+		err = c.sendRaw(ctx, http.MethodPut, c.artifactPath(subject)+"/test", []byte(request.Schema), header, nil, "compatibility check")
+	} else {
+		body := map[string]any{"content": c.versionContent(request)}
+		err = c.sendJSON(ctx, http.MethodPost, c.artifactPath(subject)+"/versions?dryRun=true", body, nil, nil, "compatibility check")
+	}
+
+	var apiErr *APIError
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		return true, nil
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict:
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to check compatibility: %w", err)
+}
+
+func (c *ApicurioClient) artifactPath(subject string) string {
+	return fmt.Sprintf("%s/groups/%s/artifacts/%s", c.basePath, url.PathEscape(c.group), url.PathEscape(subject))
+}
+
+// artifactMetaSuffix is the path of the artifact metadata below the artifact path.
+func (c *ApicurioClient) artifactMetaSuffix() string {
+	if c.apiVersion == 2 {
+		return "/meta"
+	}
+	return ""
+}
+
+// references maps Confluent references to artifact references in the same group.
+func (c *ApicurioClient) references(refs []SchemaReference) []apicurioReference {
+	result := make([]apicurioReference, 0, len(refs))
+	for _, ref := range refs {
+		result = append(result, apicurioReference{
+			GroupID:    c.group,
+			ArtifactID: ref.Subject,
+			Version:    strconv.Itoa(ref.Version),
+			Name:       ref.Name,
+		})
+	}
+	return result
+}
+
+// versionContent is the version content of the 3.x API.
+func (c *ApicurioClient) versionContent(request RegisterSchemaRequest) map[string]any {
+	return map[string]any{
+		"content":     request.Schema,
+		"contentType": contentType(request.SchemaType),
+		"references":  c.references(request.References),
+	}
+}
+
+func (c *ApicurioClient) getRule(ctx context.Context, path string) (string, error) {
+	var rule struct {
+		Config string `json:"config"`
+	}
+	if _, err := c.getJSON(ctx, path, &rule); err != nil {
+		return "", err
+	}
+	return rule.Config, nil
+}
+
+// getJSON decodes the JSON response of a GET request into out. A 404 response
+// leaves out untouched and returns false.
+func (c *ApicurioClient) getJSON(ctx context.Context, path string, out any) (bool, error) {
+	resp, err := c.send(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return false, newApicurioError("request", resp.StatusCode, body)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return true, nil
+}
+
+// getContent returns the raw content of an artifact version.
+func (c *ApicurioClient) getContent(ctx context.Context, path string) (string, error) {
+	resp, err := c.send(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", newApicurioError("request", resp.StatusCode, body)
+	}
+	return string(body), nil
+}
+
+// sendJSON sends body encoded as JSON, with Content-Type application/json unless set in header.
+func (c *ApicurioClient) sendJSON(ctx context.Context, method, path string, body any, header http.Header, out any, operation string) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	if header == nil {
+		header = http.Header{"Content-Type": {"application/json"}}
+	}
+	return c.sendRaw(ctx, method, path, data, header, out, operation)
+}
+
+// sendRaw sends the request and decodes a JSON response into out when out is set.
+// Any status outside 2xx is returned as an APIError.
+func (c *ApicurioClient) sendRaw(ctx context.Context, method, path string, body []byte, header http.Header, out any, operation string) error {
+	resp, err := c.send(ctx, method, path, body, header)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return newApicurioError(operation, resp.StatusCode, respBody)
+	}
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode %s response: %w", operation, err)
+		}
+	}
+	return nil
+}
+
+// newApicurioError builds an APIError, mapping the Apicurio exception name to a Confluent error code.
+func newApicurioError(operation string, statusCode int, body []byte) *APIError {
+	apiErr := newAPIError(operation, statusCode, body)
+	var payload struct {
+		Name string `json:"name"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		if code, ok := apicurioErrorCodes[payload.Name]; ok {
+			apiErr.ErrorCode = code
+		}
+	}
+	return apiErr
+}
+
+func (m apicurioVersionMeta) schemaType() string {
+	if m.ArtifactType != "" {
+		return m.ArtifactType
+	}
+	return m.Type
+}
+
+// artifactType maps a schema type to the Apicurio artifact type, AVRO when empty.
+func artifactType(schemaType string) string {
+	if schemaType == "" {
+		return "AVRO"
+	}
+	return schemaType
+}
+
+func contentType(schemaType string) string {
+	if schemaType == "PROTOBUF" {
+		return "application/x-protobuf"
+	}
+	return "application/json"
+}
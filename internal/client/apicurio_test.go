@@ -0,0 +1,237 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/honza/schema-strimzi-operator/internal/client"
+)
+
+func newApicurioClient(t *testing.T, flavor string, srv *httptest.Server) client.Registry {
+	t.Helper()
+	c, err := client.New(flavor, []string{srv.URL}, client.AuthConfig{Type: "NONE"}, client.Options{
+		Timeout:  5 * time.Second,
+		Apicurio: client.ApicurioOptions{GroupID: "payments", Labels: map[string]string{"owner": "team-a"}},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func TestNew_UnsupportedFlavor(t *testing.T) {
+	c, err := client.New("Pulsar", []string{"http://registry"}, client.AuthConfig{Type: "NONE"}, client.Options{})
+	if err == nil || c != nil {
+		t.Errorf("expected an error and no client, got %v, %v", c, err)
+	}
+}
+
+func TestApicurioV2_RegisterSchema(t *testing.T) {
+	var labelled bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /apis/registry/v2/groups/payments/artifacts":
+			if r.URL.Query().Get("ifExists") != "RETURN_OR_UPDATE" ||
+				r.Header.Get("X-Registry-ArtifactId") != testSubject ||
+				r.Header.Get("X-Registry-ArtifactType") != "AVRO" ||
+				r.Header.Get("Content-Type") != "application/create.extended+json" {
+				t.Errorf("unexpected create request %s %v", r.URL, r.Header)
+			}
+			var body struct {
+				Content    string `json:"content"`
+				References []struct {
+					GroupID    string `json:"groupId"`
+					ArtifactID string `json:"artifactId"`
+					Version    string `json:"version"`
+				} `json:"references"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.Content != testSchemaJSON || len(body.References) != 1 ||
+				body.References[0].GroupID != "payments" || body.References[0].Version != "3" {
+				t.Errorf("unexpected create body %+v", body)
+			}
+			_, _ = w.Write([]byte(`{"groupId":"payments","id":"users-value","version":"2","globalId":17,"contentId":5,"type":"AVRO"}`))
+		case "PUT /apis/registry/v2/groups/payments/artifacts/users-value/meta":
+			data, _ := io.ReadAll(r.Body)
+			labelled = string(data) == `{"properties":{"owner":"team-a"}}`
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newApicurioClient(t, client.FlavorApicurioV2, srv)
+	resp, err := c.RegisterSchema(context.Background(), testSubject, client.RegisterSchemaRequest{
+		Schema:     testSchemaJSON,
+		SchemaType: "AVRO",
+		References: []client.SchemaReference{{Name: "com.example.Address", Subject: "address-value", Version: 3}},
+	})
+	if err != nil {
+		t.Fatalf("RegisterSchema: %v", err)
+	}
+	if resp.ID != 5 || resp.Version != 2 {
+		t.Errorf("expected content ID 5 and version 2, got %+v", resp)
+	}
+	if !labelled {
+		t.Error("expected the labels to be set as artifact properties")
+	}
+}
+
+func TestApicurioV2_SubjectLifecycle(t *testing.T) {
+	var ruleCreated bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notFound := func(name string) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":404,"message":"not found","name":"` + name + `"}`))
+		}
+		const artifact = "/apis/registry/v2/groups/payments/artifacts/users-value"
+		switch r.Method + " " + r.URL.Path {
+		case "GET /apis/registry/v2/groups/payments/artifacts/missing-value/meta":
+			notFound("ArtifactNotFoundException")
+		case "GET " + artifact + "/meta":
+			_, _ = w.Write([]byte(`{"version":"2","contentId":5,"type":"AVRO"}`))
+		case "GET " + artifact + "/versions/2":
+			_, _ = w.Write([]byte(testSchemaJSON))
+		case "GET " + artifact + "/versions/2/references":
+			_, _ = w.Write([]byte(`[{"groupId":"payments","artifactId":"address-value","version":"1","name":"Address"}]`))
+		case "PUT " + artifact + "/test":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_code":409,"message":"incompatible","name":"RuleViolationException"}`))
+		case "PUT " + artifact + "/rules/COMPATIBILITY":
+			notFound("RuleNotFoundException")
+		case "POST " + artifact + "/rules":
+			data, _ := io.ReadAll(r.Body)
+			ruleCreated = string(data) == `{"config":"FULL","type":"COMPATIBILITY"}`
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE " + artifact:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newApicurioClient(t, client.FlavorApicurioV2, srv)
+	ctx := context.Background()
+
+	_, err := c.LookupSchema(ctx, "missing-value", client.RegisterSchemaRequest{Schema: testSchemaJSON})
+	if !client.IsSubjectNotFound(err) {
+		t.Errorf("expected subject not found, got: %v", err)
+	}
+
+	latest, err := c.GetSchema(ctx, testSubject, "latest")
+	if err != nil {
+		t.Fatalf("GetSchema: %v", err)
+	}
+	if latest.ID != 5 || latest.Version != 2 || latest.Schema != testSchemaJSON || latest.SchemaType != "AVRO" ||
+		len(latest.References) != 1 || latest.References[0].Subject != "address-value" {
+		t.Errorf("unexpected schema %+v", latest)
+	}
+
+	compatible, err := c.CheckCompatibility(ctx, testSubject, client.RegisterSchemaRequest{Schema: testSchemaJSON})
+	if err != nil || compatible {
+		t.Errorf("expected a rule violation to be incompatible, got %v, %v", compatible, err)
+	}
+
+	if err := c.SetCompatibility(ctx, testSubject, "FULL"); err != nil || !ruleCreated {
+		t.Errorf("expected the missing rule to be created, got %v", err)
+	}
+	if err := c.DeleteSubject(ctx, testSubject); err != nil {
+		t.Errorf("DeleteSubject: %v", err)
+	}
+}
+
+func TestApicurioV3(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const group = "/apis/registry/v3/groups/payments/artifacts"
+		switch r.Method + " " + r.URL.Path {
+		case "POST " + group:
+			var body struct {
+				ArtifactID   string            `json:"artifactId"`
+				ArtifactType string            `json:"artifactType"`
+				Labels       map[string]string `json:"labels"`
+				FirstVersion struct {
+					Content struct {
+						Content     string `json:"content"`
+						ContentType string `json:"contentType"`
+					} `json:"content"`
+				} `json:"firstVersion"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if r.URL.Query().Get("ifExists") != "FIND_OR_CREATE_VERSION" || body.ArtifactID != testSubject ||
+				body.ArtifactType != "PROTOBUF" || body.Labels["owner"] != "team-a" ||
+				body.FirstVersion.Content.ContentType != "application/x-protobuf" {
+				t.Errorf("unexpected create request %s %+v", r.URL, body)
+			}
+			_, _ = w.Write([]byte(`{"artifact":{"artifactId":"users-value"},"version":{"version":"1","globalId":21,"contentId":9}}`))
+		case "GET " + group:
+			_, _ = w.Write([]byte(`{"artifacts":[{"artifactId":"orders-value"},{"artifactId":"users-value"}],"count":2}`))
+		case "GET " + group + "/users-value":
+			_, _ = w.Write([]byte(`{"artifactId":"users-value","artifactType":"PROTOBUF"}`))
+		case "POST /apis/registry/v3/search/versions":
+			if r.URL.Query().Get("artifactId") != testSubject || r.URL.Query().Get("groupId") != "payments" {
+				t.Errorf("unexpected search %s", r.URL)
+			}
+			_, _ = w.Write([]byte(`{"count":0,"versions":[]}`))
+		case "POST " + group + "/users-value/versions":
+			if r.URL.Query().Get("dryRun") != "true" {
+				t.Errorf("expected a dry run, got %s", r.URL)
+			}
+			_, _ = w.Write([]byte(`{"version":"2"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newApicurioClient(t, client.FlavorApicurioV3, srv)
+	ctx := context.Background()
+	request := client.RegisterSchemaRequest{Schema: `syntax = "proto3"; message User {}`, SchemaType: "PROTOBUF"}
+
+	resp, err := c.RegisterSchema(ctx, testSubject, request)
+	if err != nil || resp.ID != 9 || resp.Version != 1 {
+		t.Errorf("expected content ID 9 and version 1, got %+v, %v", resp, err)
+	}
+
+	subjects, err := c.ListSubjects(ctx)
+	if err != nil || len(subjects) != 2 || subjects[1] != testSubject {
+		t.Errorf("unexpected subjects %v, %v", subjects, err)
+	}
+
+	_, err = c.LookupSchema(ctx, testSubject, request)
+	if !client.IsSchemaNotFound(err) {
+		t.Errorf("expected schema not found, got: %v", err)
+	}
+
+	compatible, err := c.CheckCompatibility(ctx, testSubject, request)
+	if err != nil || !compatible {
+		t.Errorf("expected the dry run to pass, got %v, %v", compatible, err)
+	}
+
+	mode, err := c.GetMode(ctx)
+	if err != nil || mode != "" {
+		t.Errorf("expected no mode support, got %q, %v", mode, err)
+	}
+}
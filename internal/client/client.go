@@ -22,17 +22,26 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/http/httpproxy"
 )
 
 // SchemaRegistryClient is an HTTP client for the Confluent Schema Registry API.
 type SchemaRegistryClient struct {
-	baseURL    string
-	httpClient *http.Client
-	auth       AuthConfig
+	*endpoints
+	// flavor is the detected implementation. Karapace and Redpanda are not asked for the
+	// server version, and Karapace not for subject mode changes.
+	flavor string
 }
 
 // AuthConfig holds authentication configuration for connecting to Schema Registry.
@@ -47,15 +56,169 @@ type AuthConfig struct {
 	BearerToken string
 	// ClientCert for MTLS auth
 	ClientCert tls.Certificate
-	// CACert pool for MTLS auth
-	CACert *x509.CertPool
+}
+
+// TLSConfig holds TLS settings applied to every connection, independent of the auth type.
+type TLSConfig struct {
+	// InsecureSkipVerify disables verification of the registry server certificate
+	InsecureSkipVerify bool
+	// RootCAs is the CA pool used to verify the registry server certificate.
+	// The system pool is used when nil.
+	RootCAs *x509.CertPool
+	// ServerName overrides the host name used for SNI and certificate verification
+	ServerName string
+	// MinVersion is the minimum TLS version, "TLSv1.2" or "TLSv1.3"
+	MinVersion string
+	// CipherSuites are the IANA names of the allowed TLS 1.2 cipher suites
+	CipherSuites []string
+}
+
+// ProxyConfig routes requests through an HTTP(S) proxy.
+type ProxyConfig struct {
+	// URL of the proxy
+	URL string
+	// NoProxy lists hosts, domains, IPs or CIDRs reached without the proxy (NO_PROXY syntax)
+	NoProxy []string
+	// Username and Password authenticate against the proxy when set
+	Username string
+	Password string
+}
+
+// Options holds the connection settings of a SchemaRegistryClient.
+type Options struct {
+	// Timeout limits each request, including connection setup
+	Timeout time.Duration
+	// TLS holds the TLS settings applied to every connection
+	TLS TLSConfig
+	// Proxy routes requests through an HTTP(S) proxy. Connections are direct when nil.
+	Proxy *ProxyConfig
+	// Headers are sent with every request. Authentication headers take precedence.
+	Headers http.Header
+	// FailoverStrategy is "Ordered" (default) or "RoundRobin"
+	FailoverStrategy string
+	// RoundRobinCounter holds the rotation of the RoundRobin strategy. Clients of the same
+	// registry that share it continue the rotation, so short-lived clients do not all
+	// start at the first endpoint. Each client rotates on its own when nil.
+	RoundRobinCounter *atomic.Uint32
+	// TracerProvider creates a client span for every HTTP request. The global
+	// provider is used when nil. The W3C trace context is always propagated.
+	TracerProvider trace.TracerProvider
+	// Apicurio configures the native Apicurio Registry API, see NewApicurioClient
+	Apicurio ApicurioOptions
+	// Glue configures the AWS Glue Schema Registry API, see NewGlueClient
+	Glue GlueOptions
+	// DetectedFlavor is the implementation of the Confluent API found by DetectFlavor,
+	// FlavorConfluent when empty. See SchemaRegistryClient for the calls it skips.
+	DetectedFlavor string
+}
+
+const (
+	// FailoverOrdered tries the endpoints in their configured order for every request.
+	FailoverOrdered = "Ordered"
+	// FailoverRoundRobin rotates the first endpoint tried between requests.
+	FailoverRoundRobin = "RoundRobin"
+)
+
+// EndpointHealth is the health check result of a single endpoint.
+type EndpointHealth struct {
+	URL     string
+	Err     error
+	Latency time.Duration
+}
+
+// APIError is an error response of the Schema Registry REST API.
+type APIError struct {
+	// Operation is the client operation that failed, e.g. "schema registration"
+	Operation string
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// ErrorCode is the Schema Registry error code, e.g. 40401 or 409, when the body carries one
+	ErrorCode int
+	// Body is the raw response body
+	Body string
+}
+
+// Error keeps the "<operation> failed with status <code>: <body>" format used across the client.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Operation, e.StatusCode, e.Body)
+}
+
+// newAPIError builds an APIError from a response, extracting the registry error code when present.
+func newAPIError(operation string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Operation:  operation,
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+	var payload struct {
+		ErrorCode int `json:"error_code"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.ErrorCode = payload.ErrorCode
+	}
+	return apiErr
+}
+
+// IsIncompatible reports whether err is the registry rejecting a schema as
+// incompatible with the existing versions of its subject (HTTP 409).
+func IsIncompatible(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// IsSubjectNotFound reports whether err is the registry answering that the subject does not exist (error code 40401).
+func IsSubjectNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == 40401
+}
+
+// IsSchemaNotFound reports whether err is the registry answering that the subject
+// exists but none of its versions holds the schema (error code 40403).
+func IsSchemaNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == 40403
+}
+
+// IsOperationNotPermitted reports whether err is the registry refusing an operation that
+// the mode of the subject does not permit, e.g. a registration in READONLY mode (error code 42205).
+func IsOperationNotPermitted(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == 42205
+}
+
+// IsUnsupported reports whether err is the registry answering that it does not serve
+// the endpoint or method at all, as opposed to a missing subject, version or schema.
+// Karapace answers with a plain text 404 or 405, Redpanda and Confluent with error code 404.
+func IsUnsupported(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	case http.StatusNotFound:
+		return apiErr.ErrorCode == 0 || apiErr.ErrorCode == http.StatusNotFound
+	}
+	return false
+}
+
+// ServerVersion is the response of GET /v1/metadata/version.
+type ServerVersion struct {
+	Version  string `json:"version"`
+	CommitID string `json:"commitId"`
 }
 
 // SchemaResponse represents the Schema Registry response for a registered schema.
 type SchemaResponse struct {
+	Subject string `json:"subject,omitempty"`
 	ID      int    `json:"id"`
-	Version int    `json:"version"`
-	Schema  string `json:"schema"`
+	// GUID identifies the version on registries without numeric schema IDs, e.g. AWS Glue
+	GUID       string            `json:"guid,omitempty"`
+	Version    int               `json:"version"`
+	SchemaType string            `json:"schemaType,omitempty"`
+	Schema     string            `json:"schema"`
+	References []SchemaReference `json:"references,omitempty"`
 }
 
 // RegisterSchemaRequest is the request body sent to register a schema.
@@ -63,6 +226,11 @@ type RegisterSchemaRequest struct {
 	Schema     string            `json:"schema"`
 	SchemaType string            `json:"schemaType"`
 	References []SchemaReference `json:"references,omitempty"`
+	// ID requests a specific schema ID. The registry only accepts it while the
+	// subject is in IMPORT mode, see SetSubjectMode.
+	ID int `json:"id,omitempty"`
+	// Version requests a specific version of the subject, also only in IMPORT mode.
+	Version int `json:"version,omitempty"`
 }
 
 // SchemaReference represents a reference to another schema subject.
@@ -72,76 +240,366 @@ type SchemaReference struct {
 	Version int    `json:"version"`
 }
 
-// NewClient creates a new SchemaRegistryClient.
-func NewClient(baseURL string, auth AuthConfig, timeout time.Duration, insecureSkipVerify bool) (*SchemaRegistryClient, error) {
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec
+// NewClient creates a new SchemaRegistryClient for one or more endpoints of the
+// same Schema Registry cluster. Requests fail over to the next endpoint on
+// connection errors and 5xx responses.
+func NewClient(baseURLs []string, auth AuthConfig, opts Options) (*SchemaRegistryClient, error) {
+	e, err := newEndpoints(baseURLs, auth, opts)
+	if err != nil {
+		return nil, err
 	}
+	return &SchemaRegistryClient{endpoints: e, flavor: opts.DetectedFlavor}, nil
+}
 
-	if auth.Type == "MTLS" {
-		tlsConfig.Certificates = []tls.Certificate{auth.ClientCert}
-		if auth.CACert != nil {
-			tlsConfig.RootCAs = auth.CACert
-		}
+// newProxyFunc builds a Transport.Proxy function for the proxy settings. Proxy
+// credentials are carried in the proxy URL, from which the transport derives
+// the Proxy-Authorization header.
+func newProxyFunc(proxy *ProxyConfig) (func(*http.Request) (*url.URL, error), error) {
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported proxy URL scheme %q", proxyURL.Scheme)
+	}
+	if proxy.Username != "" {
+		proxyURL.User = url.UserPassword(proxy.Username, proxy.Password)
 	}
 
-	httpClient := &http.Client{
-		Timeout: timeout,
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-		},
+	config := &httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    strings.Join(proxy.NoProxy, ","),
 	}
+	proxyForURL := config.ProxyFunc()
 
-	return &SchemaRegistryClient{
-		baseURL:    baseURL,
-		httpClient: httpClient,
-		auth:       auth,
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyForURL(req.URL)
 	}, nil
 }
 
+// ParseCipherSuites converts IANA cipher suite names to their crypto/tls IDs.
+// Only suites that crypto/tls considers secure are accepted.
+func ParseCipherSuites(names []string) ([]uint16, error) {
+	known := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		known[suite.Name] = suite.ID
+	}
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// AppendCertsFromPEM adds every certificate in pemData to pool. Unlike
+// x509.CertPool.AppendCertsFromPEM it fails on blocks that are not valid
+// certificates and on input without any certificate.
+func AppendCertsFromPEM(pool *x509.CertPool, pemData []byte) error {
+	count := 0
+	for rest := pemData; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			if count > 0 && len(bytes.TrimSpace(rest)) > 0 {
+				return fmt.Errorf("trailing data after certificate %d is not PEM encoded", count)
+			}
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block of type %q", block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("failed to parse certificate %d: %w", count+1, err)
+		}
+		pool.AddCert(cert)
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("no PEM encoded certificates found")
+	}
+	return nil
+}
+
+// parseTLSVersion converts a "TLSv1.x" version name to its crypto/tls constant.
+func parseTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "TLSv1.2":
+		return tls.VersionTLS12, nil
+	case "TLSv1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("unsupported TLS version %q", version)
+}
+
 // HealthCheck verifies connectivity to Schema Registry by listing subjects.
+// It succeeds when at least one endpoint responds.
 func (c *SchemaRegistryClient) HealthCheck(ctx context.Context) error {
-	url := fmt.Sprintf("%s/subjects", c.baseURL)
+	resp, err := c.do(ctx, http.MethodGet, "/subjects", nil)
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check failed with status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// CheckEndpoints health-checks every endpoint individually by listing subjects, without failover.
+func (c *SchemaRegistryClient) CheckEndpoints(ctx context.Context) []EndpointHealth {
+	return c.checkEndpoints(ctx, "/subjects")
+}
+
+// DetectFlavor identifies the implementation behind the Confluent API: Redpanda by the
+// Seastar server header, Karapace by its /_health endpoint, and FlavorConfluent otherwise.
+func (c *SchemaRegistryClient) DetectFlavor(ctx context.Context) (string, error) {
+	resp, err := c.do(ctx, http.MethodGet, "/_health", nil)
 	if err != nil {
-		return err
+		return "", fmt.Errorf("failed to detect registry flavor: %w", err)
 	}
+	defer resp.Body.Close()
 
-	c.addAuth(req)
+	switch {
+	case strings.Contains(resp.Header.Get("Server"), "Seastar"):
+		return FlavorRedpanda, nil
+	case resp.StatusCode == http.StatusOK:
+		return FlavorKarapace, nil
+	}
+	return FlavorConfluent, nil
+}
+
+// ListSubjects returns the names of all subjects registered in Schema Registry.
+func (c *SchemaRegistryClient) ListSubjects(ctx context.Context) ([]string, error) {
+	var subjects []string
+	if _, err := c.getJSON(ctx, "/subjects", &subjects); err != nil {
+		return nil, fmt.Errorf("failed to list subjects: %w", err)
+	}
+	return subjects, nil
+}
 
-	resp, err := c.httpClient.Do(req)
+// GetGlobalCompatibility returns the global compatibility level.
+func (c *SchemaRegistryClient) GetGlobalCompatibility(ctx context.Context) (string, error) {
+	var result struct {
+		CompatibilityLevel string `json:"compatibilityLevel"`
+	}
+	if _, err := c.getJSON(ctx, "/config", &result); err != nil {
+		return "", fmt.Errorf("failed to get global compatibility: %w", err)
+	}
+	return result.CompatibilityLevel, nil
+}
+
+// Registry modes of the Confluent API.
+const (
+	ModeReadWrite = "READWRITE"
+	// ModeReadOnly rejects registrations and deletions
+	ModeReadOnly = "READONLY"
+	// ModeReadOnlyOverride is READONLY that also applies to subjects with a mode of their own
+	ModeReadOnlyOverride = "READONLY_OVERRIDE"
+	// ModeImport accepts registrations with an explicit schema ID and version
+	ModeImport = "IMPORT"
+)
+
+// IsReadOnlyMode reports whether the mode rejects registrations.
+func IsReadOnlyMode(mode string) bool {
+	return mode == ModeReadOnly || mode == ModeReadOnlyOverride
+}
+
+// GetMode returns the global mode, e.g. READWRITE, READONLY or IMPORT.
+// An empty string is returned when the registry does not support modes.
+func (c *SchemaRegistryClient) GetMode(ctx context.Context) (string, error) {
+	var result struct {
+		Mode string `json:"mode"`
+	}
+	if _, err := c.getJSON(ctx, "/mode", &result); err != nil {
+		if IsUnsupported(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get mode: %w", err)
+	}
+	return result.Mode, nil
+}
+
+// SetMode sets the global mode. The change is forced, so IMPORT is accepted on a registry
+// that already has subjects.
+func (c *SchemaRegistryClient) SetMode(ctx context.Context, mode string) error {
+	bodyBytes, err := json.Marshal(map[string]string{"mode": mode})
 	if err != nil {
-		return fmt.Errorf("health check failed: %w", err)
+		return err
+	}
+
+	resp, err := c.do(ctx, http.MethodPut, "/mode?force=true", bodyBytes)
+	if err != nil {
+		return fmt.Errorf("failed to set mode: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("health check failed with status: %d", resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError("set mode", resp.StatusCode, body)
 	}
 
 	return nil
 }
 
-// RegisterSchema registers a schema under the given subject.
-// If the schema already exists, the existing ID is returned (idempotent).
-func (c *SchemaRegistryClient) RegisterSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
-	url := fmt.Sprintf("%s/subjects/%s/versions", c.baseURL, subject)
+// GetSubjectMode returns the mode that applies to the subject: its own mode, or the global
+// mode when it has none. An empty string is returned when the registry does not support modes.
+func (c *SchemaRegistryClient) GetSubjectMode(ctx context.Context, subject string) (string, error) {
+	var result struct {
+		Mode string `json:"mode"`
+	}
+	found, err := c.getJSON(ctx, fmt.Sprintf("/mode/%s?defaultToGlobal=true", subject), &result)
+	if err != nil {
+		if IsUnsupported(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get mode: %w", err)
+	}
+	if !found {
+		// Registries without defaultToGlobal answer 404 for subjects without a mode
+		return c.GetMode(ctx)
+	}
+	return result.Mode, nil
+}
 
-	body, err := json.Marshal(request)
+// GetSchemaTypes returns the schema types supported by the registry.
+// Registries that predate /schemas/types only support AVRO.
+func (c *SchemaRegistryClient) GetSchemaTypes(ctx context.Context) ([]string, error) {
+	var types []string
+	found, err := c.getJSON(ctx, "/schemas/types", &types)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal schema request: %w", err)
+		return nil, fmt.Errorf("failed to get schema types: %w", err)
 	}
+	if !found {
+		return []string{"AVRO"}, nil
+	}
+	return types, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+// GetServerVersion returns the registry server version. It returns nil when the
+// registry does not expose /v1/metadata/version (Confluent Platform before 7.x and
+// most compatible registries). Karapace and Redpanda are not asked at all.
+func (c *SchemaRegistryClient) GetServerVersion(ctx context.Context) (*ServerVersion, error) {
+	if c.flavor == FlavorKarapace || c.flavor == FlavorRedpanda {
+		return nil, nil
+	}
+
+	var result ServerVersion
+	found, err := c.getJSON(ctx, "/v1/metadata/version", &result)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// GetSubjectVersions returns the version numbers registered under subject.
+func (c *SchemaRegistryClient) GetSubjectVersions(ctx context.Context, subject string) ([]int, error) {
+	var versions []int
+	found, err := c.getJSON(ctx, fmt.Sprintf("/subjects/%s/versions", subject), &versions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subject versions: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("subject %s not found", subject)
+	}
+	return versions, nil
+}
+
+// GetSchema returns one version of subject, including its schema type and references.
+// version is a version number or "latest".
+func (c *SchemaRegistryClient) GetSchema(ctx context.Context, subject, version string) (*SchemaResponse, error) {
+	var result SchemaResponse
+	found, err := c.getJSON(ctx, fmt.Sprintf("/subjects/%s/versions/%s", subject, version), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("version %s of subject %s not found", version, subject)
+	}
+	return &result, nil
+}
+
+// SchemaByIDGetter is implemented by registries that resolve schema IDs across all
+// subjects. Only the Confluent API implements it.
+type SchemaByIDGetter interface {
+	GetSchemaByID(ctx context.Context, id int) (*SchemaResponse, error)
+}
+
+var _ SchemaByIDGetter = (*SchemaRegistryClient)(nil)
+
+// GetSchemaByID returns the schema registered under id. An unused ID is returned
+// as an APIError, see IsSchemaNotFound.
+func (c *SchemaRegistryClient) GetSchemaByID(ctx context.Context, id int) (*SchemaResponse, error) {
+	var result SchemaResponse
+	found, err := c.getJSON(ctx, fmt.Sprintf("/schemas/ids/%d", id), &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema by ID: %w", err)
+	}
+	if !found {
+		return nil, &APIError{Operation: "schema lookup by ID", StatusCode: http.StatusNotFound, ErrorCode: 40403,
+			Body: fmt.Sprintf("schema ID %d not found", id)}
+	}
+	result.ID = id
+	return &result, nil
+}
+
+// GetSubjectCompatibility returns the compatibility level configured on subject.
+// An empty string is returned when the subject inherits the global level.
+func (c *SchemaRegistryClient) GetSubjectCompatibility(ctx context.Context, subject string) (string, error) {
+	var result struct {
+		CompatibilityLevel string `json:"compatibilityLevel"`
+	}
+	if _, err := c.getJSON(ctx, fmt.Sprintf("/config/%s", subject), &result); err != nil {
+		return "", fmt.Errorf("failed to get subject compatibility: %w", err)
+	}
+	return result.CompatibilityLevel, nil
+}
+
+// getJSON decodes the JSON response of a GET request into out. A 404 response
+// leaves out untouched and returns false.
+func (c *SchemaRegistryClient) getJSON(ctx context.Context, path string, out any) (bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return false, newAPIError("request", resp.StatusCode, body)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	c.addAuth(req)
-	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	return true, nil
+}
+
+// RegisterSchema registers a schema under the given subject.
+// If the schema already exists, the existing ID is returned (idempotent).
+func (c *SchemaRegistryClient) RegisterSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema request: %w", err)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/subjects/%s/versions", subject), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register schema: %w", err)
 	}
@@ -150,7 +608,7 @@ func (c *SchemaRegistryClient) RegisterSchema(ctx context.Context, subject strin
 	respBody, _ := io.ReadAll(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("schema registration failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, newAPIError("schema registration", resp.StatusCode, respBody)
 	}
 
 	// The POST /subjects/<subject>/versions response only returns {"id": ...}.
@@ -172,18 +630,37 @@ func (c *SchemaRegistryClient) RegisterSchema(ctx context.Context, subject strin
 	return &SchemaResponse{ID: idResp.ID, Version: version}, nil
 }
 
-// getLatestVersionForSubject retrieves the latest version number registered under subject.
-func (c *SchemaRegistryClient) getLatestVersionForSubject(ctx context.Context, subject string) (int, error) {
-	url := fmt.Sprintf("%s/subjects/%s/versions/latest", c.baseURL, subject)
+// LookupSchema looks up the version of subject that holds the schema, without registering anything.
+// A missing subject or schema is returned as an APIError, see IsSubjectNotFound and IsSchemaNotFound.
+func (c *SchemaRegistryClient) LookupSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema request: %w", err)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/subjects/%s", subject), body)
 	if err != nil {
-		return 0, err
+		return nil, fmt.Errorf("failed to look up schema: %w", err)
 	}
+	defer resp.Body.Close()
 
-	c.addAuth(req)
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError("schema lookup", resp.StatusCode, respBody)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	var result SchemaResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode lookup response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// getLatestVersionForSubject retrieves the latest version number registered under subject.
+func (c *SchemaRegistryClient) getLatestVersionForSubject(ctx context.Context, subject string) (int, error) {
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/subjects/%s/versions/latest", subject), nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get latest version: %w", err)
 	}
@@ -204,16 +681,7 @@ func (c *SchemaRegistryClient) getLatestVersionForSubject(ctx context.Context, s
 // DeleteSubject deletes all versions of a subject from Schema Registry.
 // Used during finalizer cleanup when a Schema CR is deleted.
 func (c *SchemaRegistryClient) DeleteSubject(ctx context.Context, subject string) error {
-	url := fmt.Sprintf("%s/subjects/%s", c.baseURL, subject)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-	if err != nil {
-		return err
-	}
-
-	c.addAuth(req)
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/subjects/%s", subject), nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete subject: %w", err)
 	}
@@ -226,7 +694,7 @@ func (c *SchemaRegistryClient) DeleteSubject(ctx context.Context, subject string
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("delete subject failed with status %d: %s", resp.StatusCode, string(body))
+		return newAPIError("delete subject", resp.StatusCode, body)
 	}
 
 	return nil
@@ -234,43 +702,123 @@ func (c *SchemaRegistryClient) DeleteSubject(ctx context.Context, subject string
 
 // SetCompatibility sets the compatibility level for the given subject.
 func (c *SchemaRegistryClient) SetCompatibility(ctx context.Context, subject, level string) error {
-	url := fmt.Sprintf("%s/config/%s", c.baseURL, subject)
-
 	body := map[string]string{"compatibility": level}
 	bodyBytes, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(bodyBytes))
+	resp, err := c.do(ctx, http.MethodPut, fmt.Sprintf("/config/%s", subject), bodyBytes)
+	if err != nil {
+		return fmt.Errorf("failed to set compatibility: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError("set compatibility", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// SetSubjectMode sets the mode of the subject, e.g. IMPORT to register a schema under an
+// explicit ID. The change is forced, so IMPORT is accepted for subjects that already have versions.
+// Karapace only reports modes, so it is not asked at all.
+func (c *SchemaRegistryClient) SetSubjectMode(ctx context.Context, subject, mode string) error {
+	if c.flavor == FlavorKarapace {
+		return fmt.Errorf("subject modes cannot be changed on Karapace: %w", errors.ErrUnsupported)
+	}
+
+	bodyBytes, err := json.Marshal(map[string]string{"mode": mode})
 	if err != nil {
 		return err
 	}
 
-	c.addAuth(req)
-	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	resp, err := c.do(ctx, http.MethodPut, fmt.Sprintf("/mode/%s?force=true", subject), bodyBytes)
+	if err != nil {
+		return fmt.Errorf("failed to set mode: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError("set mode", resp.StatusCode, body)
+	}
+
+	return nil
+}
 
-	resp, err := c.httpClient.Do(req)
+// DeleteSubjectMode removes the subject-level mode, so the subject follows the global mode again.
+// A subject cannot have a mode of its own on registries without subject modes, so there it
+// does nothing, and Karapace is not asked at all.
+func (c *SchemaRegistryClient) DeleteSubjectMode(ctx context.Context, subject string) error {
+	if c.flavor == FlavorKarapace {
+		return nil
+	}
+
+	resp, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/mode/%s", subject), nil)
 	if err != nil {
-		return fmt.Errorf("failed to set compatibility: %w", err)
+		return fmt.Errorf("failed to delete mode: %w", err)
 	}
 	defer resp.Body.Close()
 
+	// 404 means the subject has no mode of its own
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("set compatibility failed with status %d: %s", resp.StatusCode, string(body))
+		if apiErr := newAPIError("delete mode", resp.StatusCode, body); !IsUnsupported(apiErr) {
+			return apiErr
+		}
 	}
 
 	return nil
 }
 
-// addAuth adds authentication headers to the request based on the configured auth type.
-func (c *SchemaRegistryClient) addAuth(req *http.Request) {
-	switch c.auth.Type {
-	case "BASIC":
-		req.SetBasicAuth(c.auth.Username, c.auth.Password)
-	case "BEARER":
-		req.Header.Set("Authorization", "Bearer "+c.auth.BearerToken)
+// CheckCompatibility tests the schema against the latest version registered under subject
+// using the subject's compatibility level. A subject without any versions is reported as
+// compatible, since there is nothing to check against. So is every schema on a registry that
+// does not serve the compatibility endpoint, leaving the check to the registration itself.
+func (c *SchemaRegistryClient) CheckCompatibility(ctx context.Context, subject string, request RegisterSchemaRequest) (bool, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal schema request: %w", err)
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/compatibility/subjects/%s/versions/latest", subject), body)
+	if err != nil {
+		return false, fmt.Errorf("failed to check compatibility: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 404 means the subject (or its latest version) does not exist yet
+	if resp.StatusCode == http.StatusNotFound {
+		return true, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		apiErr := newAPIError("compatibility check", resp.StatusCode, body)
+		if IsUnsupported(apiErr) {
+			return true, nil
+		}
+		return false, apiErr
 	}
-	// MTLS auth is handled via tls.Config in the transport layer
+
+	var result struct {
+		IsCompatible bool `json:"is_compatible"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("failed to decode compatibility response: %w", err)
+	}
+
+	return result.IsCompatible, nil
+}
+
+// do sends a Schema Registry API request, see endpoints.send.
+func (c *SchemaRegistryClient) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	return c.send(ctx, method, path, body, http.Header{"Content-Type": {"application/vnd.schemaregistry.v1+json"}})
 }
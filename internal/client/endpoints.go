@@ -0,0 +1,271 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// endpoints sends requests to the endpoints of one registry cluster with failover.
+// It holds the transport shared by the Confluent and Apicurio clients.
+type endpoints struct {
+	baseURLs   []string
+	strategy   string
+	next       *atomic.Uint32
+	httpClient *http.Client
+	auth       AuthConfig
+	headers    http.Header
+	// sign, when set, signs every request after all headers are applied
+	sign func(req *http.Request, body []byte) error
+}
+
+// newEndpoints sets up the HTTP transport with TLS, proxy, tracing and timeout settings.
+func newEndpoints(baseURLs []string, auth AuthConfig, opts Options) (*endpoints, error) {
+	if len(baseURLs) == 0 {
+		return nil, fmt.Errorf("at least one Schema Registry URL is required")
+	}
+
+	switch opts.FailoverStrategy {
+	case "", FailoverOrdered, FailoverRoundRobin:
+	default:
+		return nil, fmt.Errorf("unsupported failover strategy %q", opts.FailoverStrategy)
+	}
+
+	tlsOpts := opts.TLS
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: tlsOpts.InsecureSkipVerify, //nolint:gosec
+		RootCAs:            tlsOpts.RootCAs,
+		ServerName:         tlsOpts.ServerName,
+	}
+
+	if tlsOpts.MinVersion != "" {
+		version, err := parseTLSVersion(tlsOpts.MinVersion)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if len(tlsOpts.CipherSuites) > 0 {
+		suites, err := ParseCipherSuites(tlsOpts.CipherSuites)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.CipherSuites = suites
+	}
+
+	if auth.Type == "MTLS" {
+		tlsConfig.Certificates = []tls.Certificate{auth.ClientCert}
+	}
+
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	if opts.Proxy != nil {
+		proxyFunc, err := newProxyFunc(opts.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = proxyFunc
+	}
+
+	tracingOpts := []otelhttp.Option{
+		otelhttp.WithPropagators(propagation.TraceContext{}),
+		otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+			return "SchemaRegistry " + req.Method
+		}),
+	}
+	if opts.TracerProvider != nil {
+		tracingOpts = append(tracingOpts, otelhttp.WithTracerProvider(opts.TracerProvider))
+	}
+
+	httpClient := &http.Client{
+		Timeout:   opts.Timeout,
+		Transport: otelhttp.NewTransport(transport, tracingOpts...),
+	}
+
+	urls := make([]string, 0, len(baseURLs))
+	for _, baseURL := range baseURLs {
+		urls = append(urls, strings.TrimSuffix(baseURL, "/"))
+	}
+
+	next := opts.RoundRobinCounter
+	if next == nil {
+		next = new(atomic.Uint32)
+	}
+
+	return &endpoints{
+		baseURLs:   urls,
+		strategy:   opts.FailoverStrategy,
+		next:       next,
+		httpClient: httpClient,
+		auth:       auth,
+		headers:    opts.Headers.Clone(),
+	}, nil
+}
+
+// checkEndpoints health-checks every endpoint individually with a GET of path, without failover.
+func (c *endpoints) checkEndpoints(ctx context.Context, path string) []EndpointHealth {
+	results := make([]EndpointHealth, 0, len(c.baseURLs))
+	for _, baseURL := range c.baseURLs {
+		start := time.Now()
+		err := c.checkEndpoint(ctx, baseURL+path)
+		results = append(results, EndpointHealth{
+			URL:     baseURL,
+			Err:     err,
+			Latency: time.Since(start),
+		})
+	}
+	return results
+}
+
+// checkEndpoint sends a GET request to a single endpoint URL.
+func (c *endpoints) checkEndpoint(ctx context.Context, endpoint string) error {
+	req, err := c.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check failed with status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// send sends the request to the configured endpoints until one of them answers
+// without a connection error or 5xx status. The response of the last endpoint
+// is returned as-is, so callers still see its status code and body. header is
+// only set on requests with a body.
+func (c *endpoints) send(ctx context.Context, method, path string, body []byte, header http.Header) (*http.Response, error) {
+	start := 0
+	if c.strategy == FailoverRoundRobin && len(c.baseURLs) > 1 {
+		start = int((c.next.Add(1) - 1) % uint32(len(c.baseURLs)))
+	}
+
+	var errs []error
+	for i := range c.baseURLs {
+		baseURL := c.baseURLs[(start+i)%len(c.baseURLs)]
+		last := i == len(c.baseURLs)-1
+
+		req, err := c.newSignedRequest(ctx, method, baseURL+path, body, header)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		if resp.StatusCode >= http.StatusInternalServerError && !last {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			errs = append(errs, fmt.Errorf("%s returned status %d", baseURL, resp.StatusCode))
+			continue
+		}
+
+		return resp, nil
+	}
+
+	if len(errs) == 1 {
+		return nil, errs[0]
+	}
+	return nil, fmt.Errorf("all %d endpoints failed: %w", len(c.baseURLs), errors.Join(errs...))
+}
+
+// sendTo sends the request to a single endpoint, without failover.
+func (c *endpoints) sendTo(ctx context.Context, baseURL, method, path string, body []byte, header http.Header) (*http.Response, error) {
+	req, err := c.newSignedRequest(ctx, method, baseURL+path, body, header)
+	if err != nil {
+		return nil, err
+	}
+	return c.httpClient.Do(req)
+}
+
+// newSignedRequest creates a request with the configured extra headers and authentication
+// applied, signed when a signer is set. header is only set on requests with a body.
+func (c *endpoints) newSignedRequest(ctx context.Context, method, endpoint string, body []byte, header http.Header) (*http.Request, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := c.newRequest(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		for name, values := range header {
+			req.Header[name] = values
+		}
+	}
+	if c.sign != nil {
+		if err := c.sign(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// newRequest creates a request with the configured extra headers and authentication applied.
+func (c *endpoints) newRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range c.headers {
+		req.Header[name] = append([]string(nil), values...)
+	}
+	c.addAuth(req)
+
+	return req, nil
+}
+
+// addAuth adds authentication headers to the request based on the configured auth type.
+func (c *endpoints) addAuth(req *http.Request) {
+	switch c.auth.Type {
+	case "BASIC":
+		req.SetBasicAuth(c.auth.Username, c.auth.Password)
+	case "BEARER":
+		req.Header.Set("Authorization", "Bearer "+c.auth.BearerToken)
+	}
+	// MTLS auth is handled via tls.Config in the transport layer
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Exporter context types, selecting the schema context the exported subjects are placed in.
+const (
+	// ExporterContextAuto places the subjects in a context named after the source cluster.
+	ExporterContextAuto = "AUTO"
+	// ExporterContextCustom places the subjects in the context given by Exporter.Context.
+	ExporterContextCustom = "CUSTOM"
+	// ExporterContextNone keeps the subjects in the default context of the destination.
+	ExporterContextNone = "NONE"
+	// ExporterContextDefault exports the default context only, keeping subject names unchanged.
+	ExporterContextDefault = "DEFAULT"
+)
+
+// Exporter states reported by GetExporterStatus.
+const (
+	ExporterStateStarting = "STARTING"
+	ExporterStateRunning  = "RUNNING"
+	ExporterStatePaused   = "PAUSED"
+	ExporterStateError    = "ERROR"
+)
+
+// Exporter is a schema exporter of Confluent Schema Linking, copying subjects to a
+// destination registry described by Config.
+type Exporter struct {
+	Name                string            `json:"name"`
+	ContextType         string            `json:"contextType,omitempty"`
+	Context             string            `json:"context,omitempty"`
+	Subjects            []string          `json:"subjects,omitempty"`
+	SubjectRenameFormat string            `json:"subjectRenameFormat,omitempty"`
+	Config              map[string]string `json:"config,omitempty"`
+}
+
+// ExporterStatus is the state of an exporter and its position in the schemas topic.
+type ExporterStatus struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Offset int64  `json:"offset"`
+	// Timestamp is the time of the last state change in milliseconds since the epoch
+	Timestamp int64 `json:"ts"`
+	// Trace is the error of an exporter in the ERROR state
+	Trace string `json:"trace,omitempty"`
+}
+
+// ExporterManager manages the schema exporters of a registry. Only the Confluent API
+// implements it, so callers check for it with a type assertion.
+type ExporterManager interface {
+	ListExporters(ctx context.Context) ([]string, error)
+	GetExporter(ctx context.Context, name string) (*Exporter, error)
+	CreateExporter(ctx context.Context, exporter Exporter) error
+	UpdateExporter(ctx context.Context, exporter Exporter) error
+	GetExporterStatus(ctx context.Context, name string) (*ExporterStatus, error)
+	PauseExporter(ctx context.Context, name string) error
+	ResumeExporter(ctx context.Context, name string) error
+	DeleteExporter(ctx context.Context, name string) error
+}
+
+var _ ExporterManager = (*SchemaRegistryClient)(nil)
+
+// IsExporterNotFound reports whether err is the registry answering that the exporter does not exist (error code 40450).
+func IsExporterNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == 40450
+}
+
+// ListExporters returns the names of the exporters.
+func (c *SchemaRegistryClient) ListExporters(ctx context.Context) ([]string, error) {
+	var names []string
+	if err := c.exporterRequest(ctx, "list exporters", http.MethodGet, "/exporters", nil, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// GetExporter returns the exporter with its configuration, or nil when it does not exist.
+func (c *SchemaRegistryClient) GetExporter(ctx context.Context, name string) (*Exporter, error) {
+	var exporter Exporter
+	err := c.exporterRequest(ctx, "get exporter", http.MethodGet, exporterPath(name), nil, &exporter)
+	if IsExporterNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &exporter, nil
+}
+
+// CreateExporter creates the exporter, which starts exporting right away.
+func (c *SchemaRegistryClient) CreateExporter(ctx context.Context, exporter Exporter) error {
+	return c.exporterRequest(ctx, "create exporter", http.MethodPost, "/exporters", exporter, nil)
+}
+
+// UpdateExporter replaces the context, subjects and configuration of the exporter.
+func (c *SchemaRegistryClient) UpdateExporter(ctx context.Context, exporter Exporter) error {
+	// The name is part of the path and cannot be changed
+	body := exporter
+	body.Name = ""
+	return c.exporterRequest(ctx, "update exporter", http.MethodPut, exporterPath(exporter.Name), body, nil)
+}
+
+// GetExporterStatus returns the state and offset of the exporter.
+func (c *SchemaRegistryClient) GetExporterStatus(ctx context.Context, name string) (*ExporterStatus, error) {
+	var status ExporterStatus
+	if err := c.exporterRequest(ctx, "get exporter status", http.MethodGet, exporterPath(name)+"/status", nil, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// PauseExporter stops the exporter until it is resumed.
+func (c *SchemaRegistryClient) PauseExporter(ctx context.Context, name string) error {
+	return c.exporterRequest(ctx, "pause exporter", http.MethodPut, exporterPath(name)+"/pause", nil, nil)
+}
+
+// ResumeExporter restarts a paused exporter from its offset.
+func (c *SchemaRegistryClient) ResumeExporter(ctx context.Context, name string) error {
+	return c.exporterRequest(ctx, "resume exporter", http.MethodPut, exporterPath(name)+"/resume", nil, nil)
+}
+
+// DeleteExporter deletes the exporter. A missing exporter is not an error, so cleanup is idempotent.
+func (c *SchemaRegistryClient) DeleteExporter(ctx context.Context, name string) error {
+	err := c.exporterRequest(ctx, "delete exporter", http.MethodDelete, exporterPath(name), nil, nil)
+	if IsExporterNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func exporterPath(name string) string {
+	return "/exporters/" + url.PathEscape(name)
+}
+
+// exporterRequest sends an exporter API request with an optional JSON body and decodes
+// the response into out, when set. Failures are returned as an APIError.
+func (c *SchemaRegistryClient) exporterRequest(ctx context.Context, operation, method, path string, in, out any) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return fmt.Errorf("failed to marshal %s request: %w", operation, err)
+		}
+	}
+
+	resp, err := c.do(ctx, method, path, body)
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(operation, resp.StatusCode, respBody)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode %s response: %w", operation, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,485 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GlueOptions configures the AWS Glue Schema Registry API.
+type GlueOptions struct {
+	// Region of the registry, used for the default endpoint and for request signing
+	Region string
+	// RegistryName is the Glue registry subjects are mapped to, "default-registry" when empty
+	RegistryName string
+	// Credentials sign every request. EnvCredentials are used when nil.
+	Credentials CredentialsProvider
+}
+
+// GlueClient is a client for the AWS Glue Schema Registry API. A subject maps to the
+// schema with the same name in the configured registry, a version to the schema version
+// and a compatibility level to the compatibility mode of the schema. Glue identifies
+// versions by UUID rather than a numeric schema ID, so SchemaResponse.ID is always zero
+// and the SchemaVersionId is returned as SchemaResponse.GUID. Schema references are not
+// supported.
+type GlueClient struct {
+	*endpoints
+	region       string
+	registryName string
+	credentials  CredentialsProvider
+}
+
+// glueVersionPollInterval and glueVersionPollAttempts bound the wait for Glue to finish
+// the compatibility check of a new schema version.
+const (
+	glueVersionPollInterval = 200 * time.Millisecond
+	glueVersionPollAttempts = 50
+)
+
+// glueCompatibility maps Confluent compatibility levels to Glue compatibility modes.
+var glueCompatibility = map[string]string{
+	"NONE":                "NONE",
+	"BACKWARD":            "BACKWARD",
+	"BACKWARD_TRANSITIVE": "BACKWARD_ALL",
+	"FORWARD":             "FORWARD",
+	"FORWARD_TRANSITIVE":  "FORWARD_ALL",
+	"FULL":                "FULL",
+	"FULL_TRANSITIVE":     "FULL_ALL",
+}
+
+type glueSchemaID struct {
+	RegistryName string `json:"RegistryName"`
+	SchemaName   string `json:"SchemaName"`
+}
+
+type glueSchemaVersion struct {
+	SchemaVersionID  string `json:"SchemaVersionId"`
+	SchemaDefinition string `json:"SchemaDefinition"`
+	DataFormat       string `json:"DataFormat"`
+	VersionNumber    int    `json:"VersionNumber"`
+	Status           string `json:"Status"`
+}
+
+// NewGlueClient creates a client for AWS Glue Schema Registry. The base URLs default to
+// the regional endpoint https://glue.<region>.amazonaws.com, e.g. for a VPC endpoint.
+func NewGlueClient(baseURLs []string, auth AuthConfig, opts Options) (*GlueClient, error) {
+	glue := opts.Glue
+	if glue.Region == "" {
+		return nil, fmt.Errorf("an AWS region is required for Glue Schema Registry")
+	}
+	if len(baseURLs) == 0 {
+		baseURLs = []string{fmt.Sprintf("https://glue.%s.amazonaws.com", glue.Region)}
+	}
+	e, err := newEndpoints(baseURLs, auth, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &GlueClient{
+		endpoints:    e,
+		region:       glue.Region,
+		registryName: glue.RegistryName,
+		credentials:  glue.Credentials,
+	}
+	if c.registryName == "" {
+		c.registryName = "default-registry"
+	}
+	if c.credentials == nil {
+		c.credentials = EnvCredentials(glue.Region)
+	}
+	e.sign = c.sign
+	return c, nil
+}
+
+// sign signs a request with the current credentials for the glue service.
+func (c *GlueClient) sign(req *http.Request, body []byte) error {
+	creds, err := c.credentials.Retrieve(req.Context())
+	if err != nil {
+		return fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+	signV4(req, body, creds, c.region, "glue", time.Now())
+	return nil
+}
+
+// HealthCheck verifies connectivity and credentials by reading the registry.
+func (c *GlueClient) HealthCheck(ctx context.Context) error {
+	in := map[string]any{"RegistryId": map[string]string{"RegistryName": c.registryName}}
+	if err := c.call(ctx, "GetRegistry", in, nil); err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	return nil
+}
+
+// CheckEndpoints reads the registry through each endpoint individually, without failover.
+func (c *GlueClient) CheckEndpoints(ctx context.Context) []EndpointHealth {
+	in := map[string]any{"RegistryId": map[string]string{"RegistryName": c.registryName}}
+	results := make([]EndpointHealth, 0, len(c.baseURLs))
+	for _, baseURL := range c.baseURLs {
+		start := time.Now()
+		err := c.callEndpoint(ctx, baseURL, "GetRegistry", in, nil)
+		if err != nil {
+			err = fmt.Errorf("health check failed: %w", err)
+		}
+		results = append(results, EndpointHealth{URL: baseURL, Err: err, Latency: time.Since(start)})
+	}
+	return results
+}
+
+// DetectFlavor returns FlavorGlue without a request.
+func (c *GlueClient) DetectFlavor(context.Context) (string, error) {
+	return FlavorGlue, nil
+}
+
+// ListSubjects returns the names of all schemas in the registry.
+func (c *GlueClient) ListSubjects(ctx context.Context) ([]string, error) {
+	subjects := []string{}
+	nextToken := ""
+	for {
+		in := map[string]any{"RegistryId": map[string]string{"RegistryName": c.registryName}, "MaxResults": 100}
+		if nextToken != "" {
+			in["NextToken"] = nextToken
+		}
+		var page struct {
+			Schemas []struct {
+				SchemaName string `json:"SchemaName"`
+			} `json:"Schemas"`
+			NextToken string `json:"NextToken"`
+		}
+		if err := c.call(ctx, "ListSchemas", in, &page); err != nil {
+			return nil, fmt.Errorf("failed to list subjects: %w", err)
+		}
+		for _, schema := range page.Schemas {
+			subjects = append(subjects, schema.SchemaName)
+		}
+		if page.NextToken == "" {
+			return subjects, nil
+		}
+		nextToken = page.NextToken
+	}
+}
+
+// GetGlobalCompatibility returns an empty string, Glue only sets compatibility per schema.
+func (c *GlueClient) GetGlobalCompatibility(context.Context) (string, error) {
+	return "", nil
+}
+
+// GetMode returns an empty string, Glue has no registry modes.
+func (c *GlueClient) GetMode(context.Context) (string, error) {
+	return "", nil
+}
+
+// SetMode fails, Glue has no registry modes.
+func (c *GlueClient) SetMode(context.Context, string) error {
+	return fmt.Errorf("registry modes are not supported by Glue: %w", errors.ErrUnsupported)
+}
+
+// GetSubjectMode returns an empty string, Glue has no registry modes.
+func (c *GlueClient) GetSubjectMode(context.Context, string) (string, error) {
+	return "", nil
+}
+
+// SetSubjectMode fails, Glue assigns its own schema version IDs.
+func (c *GlueClient) SetSubjectMode(context.Context, string, string) error {
+	return fmt.Errorf("registry modes are not supported by Glue: %w", errors.ErrUnsupported)
+}
+
+// DeleteSubjectMode does nothing, Glue has no registry modes.
+func (c *GlueClient) DeleteSubjectMode(context.Context, string) error {
+	return nil
+}
+
+// GetSchemaTypes returns the data formats supported by Glue.
+func (c *GlueClient) GetSchemaTypes(context.Context) ([]string, error) {
+	return []string{"AVRO", "JSON", "PROTOBUF"}, nil
+}
+
+// GetServerVersion returns nil, Glue does not report a version.
+func (c *GlueClient) GetServerVersion(context.Context) (*ServerVersion, error) {
+	return nil, nil
+}
+
+// GetSubjectVersions returns the available version numbers of the schema.
+func (c *GlueClient) GetSubjectVersions(ctx context.Context, subject string) ([]int, error) {
+	var versions []int
+	nextToken := ""
+	for {
+		in := map[string]any{"SchemaId": c.schemaID(subject), "MaxResults": 100}
+		if nextToken != "" {
+			in["NextToken"] = nextToken
+		}
+		var page struct {
+			Schemas   []glueSchemaVersion `json:"Schemas"`
+			NextToken string              `json:"NextToken"`
+		}
+		if err := c.call(ctx, "ListSchemaVersions", in, &page); err != nil {
+			if IsSubjectNotFound(err) {
+				return nil, fmt.Errorf("subject %s not found", subject)
+			}
+			return nil, fmt.Errorf("failed to get subject versions: %w", err)
+		}
+		for _, v := range page.Schemas {
+			if v.Status == "AVAILABLE" {
+				versions = append(versions, v.VersionNumber)
+			}
+		}
+		if page.NextToken == "" {
+			break
+		}
+		nextToken = page.NextToken
+	}
+	slices.Sort(versions)
+	return versions, nil
+}
+
+// GetSchema returns one version of the schema. version is a version number or "latest".
+func (c *GlueClient) GetSchema(ctx context.Context, subject, version string) (*SchemaResponse, error) {
+	versionNumber := map[string]any{"LatestVersion": true}
+	if version != "latest" {
+		n, err := strconv.Atoi(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q", version)
+		}
+		versionNumber = map[string]any{"VersionNumber": n}
+	}
+
+	var result glueSchemaVersion
+	in := map[string]any{"SchemaId": c.schemaID(subject), "SchemaVersionNumber": versionNumber}
+	if err := c.call(ctx, "GetSchemaVersion", in, &result); err != nil {
+		if IsSubjectNotFound(err) {
+			return nil, fmt.Errorf("version %s of subject %s not found", version, subject)
+		}
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+	return c.response(subject, result), nil
+}
+
+// GetSubjectCompatibility returns the compatibility mode of the schema as a Confluent level.
+func (c *GlueClient) GetSubjectCompatibility(ctx context.Context, subject string) (string, error) {
+	var result struct {
+		Compatibility string `json:"Compatibility"`
+	}
+	if err := c.call(ctx, "GetSchema", map[string]any{"SchemaId": c.schemaID(subject)}, &result); err != nil {
+		return "", fmt.Errorf("failed to get subject compatibility: %w", err)
+	}
+	for level, mode := range glueCompatibility {
+		if mode == result.Compatibility {
+			return level, nil
+		}
+	}
+	return result.Compatibility, nil
+}
+
+// RegisterSchema adds a version to the schema, creating the schema on first use. Glue checks
+// compatibility asynchronously, so RegisterSchema waits for the version to become available;
+// a version that fails the check is returned as an incompatible APIError, see IsIncompatible.
+// Registering a definition that already exists returns the existing version.
+func (c *GlueClient) RegisterSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
+	if len(request.References) > 0 {
+		return nil, fmt.Errorf("glue schema registry does not support schema references")
+	}
+
+	var version glueSchemaVersion
+	in := map[string]any{"SchemaId": c.schemaID(subject), "SchemaDefinition": request.Schema}
+	err := c.call(ctx, "RegisterSchemaVersion", in, &version)
+	if IsSubjectNotFound(err) {
+		var created struct {
+			SchemaVersionID     string `json:"SchemaVersionId"`
+			SchemaVersionStatus string `json:"SchemaVersionStatus"`
+			LatestSchemaVersion int    `json:"LatestSchemaVersion"`
+		}
+		in := map[string]any{
+			"RegistryId":       map[string]string{"RegistryName": c.registryName},
+			"SchemaName":       subject,
+			"DataFormat":       artifactType(request.SchemaType),
+			"SchemaDefinition": request.Schema,
+		}
+		err = c.call(ctx, "CreateSchema", in, &created)
+		version = glueSchemaVersion{
+			SchemaVersionID: created.SchemaVersionID,
+			VersionNumber:   created.LatestSchemaVersion,
+			Status:          created.SchemaVersionStatus,
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to register schema: %w", err)
+	}
+
+	for attempt := 0; version.Status == "PENDING"; attempt++ {
+		if attempt == glueVersionPollAttempts {
+			return nil, fmt.Errorf("version %d of subject %s is still pending", version.VersionNumber, subject)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(glueVersionPollInterval):
+		}
+		if err := c.call(ctx, "GetSchemaVersion", map[string]any{"SchemaVersionId": version.SchemaVersionID}, &version); err != nil {
+			return nil, fmt.Errorf("failed to get schema version status: %w", err)
+		}
+	}
+
+	switch version.Status {
+	case "AVAILABLE":
+	case "FAILURE":
+		return nil, &APIError{Operation: "schema registration", StatusCode: http.StatusConflict, ErrorCode: http.StatusConflict,
+			Body: fmt.Sprintf("version %d of subject %s failed the compatibility check", version.VersionNumber, subject)}
+	default:
+		return nil, fmt.Errorf("version %d of subject %s has status %s", version.VersionNumber, subject, version.Status)
+	}
+
+	resp := c.response(subject, version)
+	resp.SchemaType = artifactType(request.SchemaType)
+	resp.Schema = request.Schema
+	return resp, nil
+}
+
+// LookupSchema finds the version of the schema holding the definition, without registering anything.
+// A missing schema or definition is returned as an APIError, see IsSubjectNotFound and IsSchemaNotFound.
+func (c *GlueClient) LookupSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error) {
+	if err := c.call(ctx, "GetSchema", map[string]any{"SchemaId": c.schemaID(subject)}, nil); err != nil {
+		return nil, fmt.Errorf("failed to look up schema: %w", err)
+	}
+
+	var found glueSchemaVersion
+	in := map[string]any{"SchemaId": c.schemaID(subject), "SchemaDefinition": request.Schema}
+	if err := c.call(ctx, "GetSchemaByDefinition", in, &found); err != nil {
+		if IsSubjectNotFound(err) {
+			return nil, &APIError{Operation: "schema lookup", StatusCode: http.StatusNotFound, ErrorCode: 40403,
+				Body: fmt.Sprintf("no version of schema %s holds the definition", subject)}
+		}
+		return nil, fmt.Errorf("failed to look up schema: %w", err)
+	}
+
+	var version glueSchemaVersion
+	if err := c.call(ctx, "GetSchemaVersion", map[string]any{"SchemaVersionId": found.SchemaVersionID}, &version); err != nil {
+		return nil, fmt.Errorf("failed to look up schema: %w", err)
+	}
+	return c.response(subject, version), nil
+}
+
+// DeleteSubject deletes the schema with all its versions. A missing schema is not an error.
+func (c *GlueClient) DeleteSubject(ctx context.Context, subject string) error {
+	err := c.call(ctx, "DeleteSchema", map[string]any{"SchemaId": c.schemaID(subject)}, nil)
+	if err != nil && !IsSubjectNotFound(err) {
+		return fmt.Errorf("failed to delete subject: %w", err)
+	}
+	return nil
+}
+
+// SetCompatibility sets the compatibility mode of the schema.
+func (c *GlueClient) SetCompatibility(ctx context.Context, subject, level string) error {
+	mode, ok := glueCompatibility[level]
+	if !ok {
+		return fmt.Errorf("compatibility level %s is not supported by Glue", level)
+	}
+	in := map[string]any{"SchemaId": c.schemaID(subject), "Compatibility": mode}
+	if err := c.call(ctx, "UpdateSchema", in, nil); err != nil {
+		return fmt.Errorf("failed to set compatibility: %w", err)
+	}
+	return nil
+}
+
+// CheckCompatibility reports every schema as compatible. Glue has no dry run; it checks
+// compatibility when a version is registered, see RegisterSchema.
+func (c *GlueClient) CheckCompatibility(context.Context, string, RegisterSchemaRequest) (bool, error) {
+	return true, nil
+}
+
+func (c *GlueClient) schemaID(subject string) glueSchemaID {
+	return glueSchemaID{RegistryName: c.registryName, SchemaName: subject}
+}
+
+func (c *GlueClient) response(subject string, v glueSchemaVersion) *SchemaResponse {
+	return &SchemaResponse{
+		Subject:    subject,
+		GUID:       v.SchemaVersionID,
+		Version:    v.VersionNumber,
+		SchemaType: v.DataFormat,
+		Schema:     v.SchemaDefinition,
+	}
+}
+
+// call invokes a Glue API operation with the JSON 1.1 protocol and decodes the
+// response into out when out is set.
+func (c *GlueClient) call(ctx context.Context, operation string, in, out any) error {
+	return c.callEndpoint(ctx, "", operation, in, out)
+}
+
+// callEndpoint calls the operation on baseURL only, or on every endpoint with failover
+// when baseURL is empty.
+func (c *GlueClient) callEndpoint(ctx context.Context, baseURL, operation string, in, out any) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s request: %w", operation, err)
+	}
+
+	header := http.Header{
+		"Content-Type": {"application/x-amz-json-1.1"},
+		"X-Amz-Target": {"AWSGlue." + operation},
+	}
+	var resp *http.Response
+	if baseURL == "" {
+		resp, err = c.send(ctx, http.MethodPost, "/", body, header)
+	} else {
+		resp, err = c.sendTo(ctx, baseURL, http.MethodPost, "/", body, header)
+	}
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return newGlueError(operation, resp.StatusCode, resp.Header.Get("X-Amzn-ErrorType"), respBody)
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode %s response: %w", operation, err)
+		}
+	}
+	return nil
+}
+
+// newGlueError builds an APIError, mapping EntityNotFoundException to the Confluent
+// error code for a missing subject. Glue reports the exception in the __type field,
+// optionally prefixed with its namespace, or in the X-Amzn-ErrorType header.
+func newGlueError(operation string, statusCode int, errorType string, body []byte) *APIError {
+	apiErr := &APIError{Operation: operation, StatusCode: statusCode, Body: string(body)}
+	var payload struct {
+		Type string `json:"__type"`
+	}
+	if errorType == "" && json.Unmarshal(body, &payload) == nil {
+		errorType = payload.Type
+	}
+	errorType, _, _ = strings.Cut(errorType, ":")
+	if i := strings.LastIndex(errorType, "#"); i >= 0 {
+		errorType = errorType[i+1:]
+	}
+	if errorType == "EntityNotFoundException" {
+		apiErr.ErrorCode = 40401
+	}
+	return apiErr
+}
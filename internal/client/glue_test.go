@@ -0,0 +1,208 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/honza/schema-strimzi-operator/internal/client"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
+)
+
+func newGlueClient(t *testing.T, srv *registrytest.GlueServer, accessKeyID string) client.Registry {
+	t.Helper()
+	c, err := client.New(client.FlavorGlue, []string{srv.URL}, client.AuthConfig{Type: "NONE"}, client.Options{
+		Timeout: 5 * time.Second,
+		Glue: client.GlueOptions{
+			Region:       "eu-west-1",
+			RegistryName: srv.RegistryName,
+			Credentials: client.StaticCredentials{
+				AccessKeyID: accessKeyID, SecretAccessKey: "secret", SessionToken: "session",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func TestGlue_SubjectLifecycle(t *testing.T) {
+	srv := registrytest.NewGlueServer()
+	defer srv.Close()
+	srv.RequireSigV4("AKIDTEST")
+
+	c := newGlueClient(t, srv, "AKIDTEST")
+	ctx := context.Background()
+
+	if err := c.HealthCheck(ctx); err != nil {
+		t.Fatalf("HealthCheck: %v", err)
+	}
+
+	v1 := client.RegisterSchemaRequest{Schema: testSchemaJSON, SchemaType: "AVRO"}
+	resp, err := c.RegisterSchema(ctx, testSubject, v1)
+	if err != nil || resp.Version != 1 || resp.GUID == "" {
+		t.Fatalf("RegisterSchema v1: got %+v, %v", resp, err)
+	}
+	if again, err := c.RegisterSchema(ctx, testSubject, v1); err != nil || again.Version != 1 || again.GUID != resp.GUID {
+		t.Errorf("RegisterSchema v1 again: expected the same version, got %+v, %v", again, err)
+	}
+
+	v2 := client.RegisterSchemaRequest{SchemaType: "AVRO",
+		Schema: `{"type":"record","name":"User","fields":[{"name":"id","type":"string"},{"name":"email","type":"string","default":""}]}`}
+	if resp, err := c.RegisterSchema(ctx, testSubject, v2); err != nil || resp.Version != 2 {
+		t.Errorf("RegisterSchema v2: got %+v, %v", resp, err)
+	}
+
+	incompatible := client.RegisterSchemaRequest{SchemaType: "AVRO",
+		Schema: `{"type":"record","name":"User","fields":[{"name":"id","type":"string"},{"name":"age","type":"int"}]}`}
+	if _, err := c.RegisterSchema(ctx, testSubject, incompatible); !client.IsIncompatible(err) {
+		t.Errorf("RegisterSchema incompatible: expected an incompatible error, got %v", err)
+	}
+
+	found, err := c.LookupSchema(ctx, testSubject, v1)
+	if err != nil || found.Version != 1 || found.GUID != resp.GUID {
+		t.Errorf("LookupSchema v1: got %+v, %v", found, err)
+	}
+	if _, err := c.LookupSchema(ctx, testSubject, incompatible); !client.IsSchemaNotFound(err) {
+		t.Errorf("LookupSchema unknown schema: expected schema not found, got %v", err)
+	}
+	if _, err := c.LookupSchema(ctx, "missing-value", v1); !client.IsSubjectNotFound(err) {
+		t.Errorf("LookupSchema missing subject: expected subject not found, got %v", err)
+	}
+
+	if err := c.SetCompatibility(ctx, testSubject, "FULL_TRANSITIVE"); err != nil {
+		t.Fatalf("SetCompatibility: %v", err)
+	}
+	if level, err := c.GetSubjectCompatibility(ctx, testSubject); err != nil || level != "FULL_TRANSITIVE" {
+		t.Errorf("GetSubjectCompatibility: got %q, %v", level, err)
+	}
+
+	if subjects, err := c.ListSubjects(ctx); err != nil || !slices.Equal(subjects, []string{testSubject}) {
+		t.Errorf("ListSubjects: got %v, %v", subjects, err)
+	}
+	if versions, err := c.GetSubjectVersions(ctx, testSubject); err != nil || !slices.Equal(versions, []int{1, 2}) {
+		t.Errorf("GetSubjectVersions: got %v, %v", versions, err)
+	}
+	if latest, err := c.GetSchema(ctx, testSubject, "latest"); err != nil || latest.Version != 2 || latest.SchemaType != "AVRO" {
+		t.Errorf("GetSchema latest: got %+v, %v", latest, err)
+	}
+
+	if err := c.DeleteSubject(ctx, testSubject); err != nil {
+		t.Fatalf("DeleteSubject: %v", err)
+	}
+	if err := c.DeleteSubject(ctx, testSubject); err != nil {
+		t.Errorf("DeleteSubject again: expected no error, got %v", err)
+	}
+	if versions := srv.Versions(testSubject); len(versions) != 0 {
+		t.Errorf("expected the schema to be gone, got versions %v", versions)
+	}
+}
+
+func TestGlue_CheckEndpointsWithoutFailover(t *testing.T) {
+	srv := registrytest.NewGlueServer()
+	defer srv.Close()
+	srv.RequireSigV4("AKIDTEST")
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+
+	c, err := client.New(client.FlavorGlue, []string{dead.URL, srv.URL}, client.AuthConfig{Type: "NONE"}, client.Options{
+		Timeout: 5 * time.Second,
+		Glue: client.GlueOptions{
+			Region:       "eu-west-1",
+			RegistryName: srv.RegistryName,
+			Credentials:  client.StaticCredentials{AccessKeyID: "AKIDTEST", SecretAccessKey: "secret"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	results := c.CheckEndpoints(context.Background())
+	if len(results) != 2 {
+		t.Fatalf("expected a result per endpoint, got %+v", results)
+	}
+	if results[0].URL != dead.URL || results[0].Err == nil {
+		t.Errorf("expected the dead endpoint to be unreachable, got %+v", results[0])
+	}
+	if results[1].URL != srv.URL || results[1].Err != nil {
+		t.Errorf("expected the live endpoint to be reachable, got %+v", results[1])
+	}
+}
+
+func TestGlue_RejectsReferencesAndWrongCredentials(t *testing.T) {
+	srv := registrytest.NewGlueServer()
+	defer srv.Close()
+	srv.RequireSigV4("AKIDTEST")
+	ctx := context.Background()
+
+	withRefs := client.RegisterSchemaRequest{
+		Schema:     testSchemaJSON,
+		References: []client.SchemaReference{{Name: "Address", Subject: "address-value", Version: 1}},
+	}
+	if _, err := newGlueClient(t, srv, "AKIDTEST").RegisterSchema(ctx, testSubject, withRefs); err == nil {
+		t.Error("expected schema references to be rejected")
+	}
+	if err := newGlueClient(t, srv, "AKIDOTHER").HealthCheck(ctx); err == nil {
+		t.Error("expected a request signed with other credentials to be rejected")
+	}
+}
+
+func TestWebIdentityCredentials_AssumesRoleOnce(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("service-account-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var calls int
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if err := r.ParseForm(); err != nil ||
+			r.PostForm.Get("Action") != "AssumeRoleWithWebIdentity" ||
+			r.PostForm.Get("RoleArn") != "arn:aws:iam::123456789012:role/schemas" ||
+			r.PostForm.Get("WebIdentityToken") != "service-account-token" {
+			t.Errorf("unexpected STS request: %v", r.PostForm)
+		}
+		_, _ = w.Write([]byte(`<AssumeRoleWithWebIdentityResponse><AssumeRoleWithWebIdentityResult><Credentials>` +
+			`<AccessKeyId>ASIATEST</AccessKeyId><SecretAccessKey>secret</SecretAccessKey>` +
+			`<SessionToken>session</SessionToken><Expiration>` + time.Now().Add(time.Hour).UTC().Format(time.RFC3339) +
+			`</Expiration></Credentials></AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`))
+	}))
+	defer sts.Close()
+
+	provider := &client.WebIdentityCredentials{
+		RoleARN:     "arn:aws:iam::123456789012:role/schemas",
+		TokenFile:   tokenFile,
+		STSEndpoint: sts.URL,
+	}
+	for range 2 {
+		creds, err := provider.Retrieve(context.Background())
+		if err != nil || creds.AccessKeyID != "ASIATEST" || creds.SessionToken != "session" {
+			t.Fatalf("Retrieve: got %+v, %v", creds, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the credentials to be cached, got %d STS calls", calls)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Registry flavors, selecting the API used to talk to the registry.
+const (
+	// FlavorConfluent is the Confluent Schema Registry REST API, also served by
+	// Karapace, Redpanda and the compatibility layer of Apicurio Registry.
+	FlavorConfluent = "Confluent"
+	// FlavorApicurioV2 is the native Apicurio Registry 2.x API.
+	FlavorApicurioV2 = "ApicurioV2"
+	// FlavorApicurioV3 is the native Apicurio Registry 3.x API.
+	FlavorApicurioV3 = "ApicurioV3"
+	// FlavorGlue is the AWS Glue Schema Registry API.
+	FlavorGlue = "Glue"
+)
+
+// Implementations of the Confluent API reported by DetectFlavor, next to FlavorConfluent.
+// They are detected rather than selected, see Options.DetectedFlavor.
+const (
+	// FlavorKarapace is the Aiven Karapace schema registry.
+	FlavorKarapace = "Karapace"
+	// FlavorRedpanda is the schema registry built into Redpanda brokers.
+	FlavorRedpanda = "Redpanda"
+)
+
+// Registry is the subset of schema registry operations used by the controllers
+// and the CLIs, implemented for each registry flavor. Subjects, versions and IDs
+// follow the Confluent model; other implementations map them to their own.
+type Registry interface {
+	HealthCheck(ctx context.Context) error
+	DetectFlavor(ctx context.Context) (string, error)
+	CheckEndpoints(ctx context.Context) []EndpointHealth
+	ListSubjects(ctx context.Context) ([]string, error)
+	GetGlobalCompatibility(ctx context.Context) (string, error)
+	GetMode(ctx context.Context) (string, error)
+	SetMode(ctx context.Context, mode string) error
+	GetSchemaTypes(ctx context.Context) ([]string, error)
+	GetServerVersion(ctx context.Context) (*ServerVersion, error)
+	GetSubjectVersions(ctx context.Context, subject string) ([]int, error)
+	GetSchema(ctx context.Context, subject, version string) (*SchemaResponse, error)
+	GetSubjectCompatibility(ctx context.Context, subject string) (string, error)
+	RegisterSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error)
+	LookupSchema(ctx context.Context, subject string, request RegisterSchemaRequest) (*SchemaResponse, error)
+	DeleteSubject(ctx context.Context, subject string) error
+	SetCompatibility(ctx context.Context, subject, level string) error
+	GetSubjectMode(ctx context.Context, subject string) (string, error)
+	SetSubjectMode(ctx context.Context, subject, mode string) error
+	DeleteSubjectMode(ctx context.Context, subject string) error
+	CheckCompatibility(ctx context.Context, subject string, request RegisterSchemaRequest) (bool, error)
+}
+
+var (
+	_ Registry = (*SchemaRegistryClient)(nil)
+	_ Registry = (*ApicurioClient)(nil)
+	_ Registry = (*GlueClient)(nil)
+)
+
+// New creates the client for the given registry flavor. An empty flavor is Confluent.
+func New(flavor string, baseURLs []string, auth AuthConfig, opts Options) (Registry, error) {
+	var (
+		registry Registry
+		err      error
+	)
+	switch flavor {
+	case "", FlavorConfluent:
+		registry, err = NewClient(baseURLs, auth, opts)
+	case FlavorApicurioV2:
+		registry, err = NewApicurioClient(2, baseURLs, auth, opts)
+	case FlavorApicurioV3:
+		registry, err = NewApicurioClient(3, baseURLs, auth, opts)
+	case FlavorGlue:
+		registry, err = NewGlueClient(baseURLs, auth, opts)
+	default:
+		return nil, fmt.Errorf("unsupported registry flavor %q", flavor)
+	}
+	if err != nil {
+		// Avoid returning a typed nil pointer as a non-nil Registry
+		return nil, err
+	}
+	return registry, nil
+}
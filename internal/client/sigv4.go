@@ -0,0 +1,271 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AWSCredentials are the IAM credentials used to sign requests with Signature Version 4.
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set for temporary credentials, e.g. from IRSA
+	SessionToken string
+	// Expires is zero for credentials that do not expire
+	Expires time.Time
+}
+
+// CredentialsProvider returns the credentials to sign the next request with.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (AWSCredentials, error)
+}
+
+// StaticCredentials is a CredentialsProvider that always returns the same credentials.
+type StaticCredentials AWSCredentials
+
+// Retrieve returns the static credentials.
+func (c StaticCredentials) Retrieve(context.Context) (AWSCredentials, error) {
+	if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+		return AWSCredentials{}, fmt.Errorf("AWS access key ID and secret access key are required")
+	}
+	return AWSCredentials(c), nil
+}
+
+// WebIdentityCredentials exchanges a web identity token for temporary credentials of
+// a role with STS AssumeRoleWithWebIdentity. This is how IAM Roles for Service Accounts
+// (IRSA) work: EKS mounts the token of the service account and sets AWS_ROLE_ARN and
+// AWS_WEB_IDENTITY_TOKEN_FILE. The credentials are cached until shortly before they expire.
+type WebIdentityCredentials struct {
+	RoleARN     string
+	TokenFile   string
+	SessionName string
+	// STSEndpoint is https://sts.<region>.amazonaws.com when empty
+	STSEndpoint string
+	Region      string
+	HTTPClient  *http.Client
+
+	mu     sync.Mutex
+	cached AWSCredentials
+}
+
+// credentialsRefreshWindow is how long before expiry cached credentials are refreshed.
+const credentialsRefreshWindow = 5 * time.Minute
+
+// Retrieve returns the cached credentials, assuming the role again when they are about to expire.
+func (c *WebIdentityCredentials) Retrieve(ctx context.Context) (AWSCredentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached.AccessKeyID != "" && time.Until(c.cached.Expires) > credentialsRefreshWindow {
+		return c.cached, nil
+	}
+
+	token, err := os.ReadFile(c.TokenFile)
+	if err != nil {
+		return AWSCredentials{}, fmt.Errorf("failed to read web identity token: %w", err)
+	}
+
+	endpoint := c.STSEndpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://sts.%s.amazonaws.com", c.Region)
+	}
+	sessionName := c.SessionName
+	if sessionName == "" {
+		sessionName = "schema-strimzi-operator"
+	}
+	form := url.Values{
+		"Action":           {"AssumeRoleWithWebIdentity"},
+		"Version":          {"2011-06-15"},
+		"RoleArn":          {c.RoleARN},
+		"RoleSessionName":  {sessionName},
+		"WebIdentityToken": {strings.TrimSpace(string(token))},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return AWSCredentials{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return AWSCredentials{}, fmt.Errorf("failed to assume role %s: %w", c.RoleARN, err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return AWSCredentials{}, fmt.Errorf("failed to assume role %s: status %d: %s", c.RoleARN, resp.StatusCode, body)
+	}
+
+	var result struct {
+		Credentials struct {
+			AccessKeyID     string    `xml:"AccessKeyId"`
+			SecretAccessKey string    `xml:"SecretAccessKey"`
+			SessionToken    string    `xml:"SessionToken"`
+			Expiration      time.Time `xml:"Expiration"`
+		} `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+	}
+	if err := xml.Unmarshal(body, &result); err != nil {
+		return AWSCredentials{}, fmt.Errorf("failed to decode AssumeRoleWithWebIdentity response: %w", err)
+	}
+
+	c.cached = AWSCredentials{
+		AccessKeyID:     result.Credentials.AccessKeyID,
+		SecretAccessKey: result.Credentials.SecretAccessKey,
+		SessionToken:    result.Credentials.SessionToken,
+		Expires:         result.Credentials.Expiration,
+	}
+	return c.cached, nil
+}
+
+var (
+	envCredentialsOnce sync.Once
+	envCredentials     CredentialsProvider
+)
+
+// EnvCredentials returns the credentials of the operator process: IRSA when
+// AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE are set, otherwise the static
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN. The provider is
+// shared by all clients, so temporary credentials are not requested per reconcile.
+// region selects the STS endpoint when AWS_REGION is unset, on the first call only.
+func EnvCredentials(region string) CredentialsProvider {
+	envCredentialsOnce.Do(func() {
+		if roleARN, tokenFile := os.Getenv("AWS_ROLE_ARN"), os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"); roleARN != "" && tokenFile != "" {
+			stsRegion := os.Getenv("AWS_REGION")
+			if stsRegion == "" {
+				stsRegion = region
+			}
+			envCredentials = &WebIdentityCredentials{
+				RoleARN:     roleARN,
+				TokenFile:   tokenFile,
+				SessionName: os.Getenv("AWS_ROLE_SESSION_NAME"),
+				Region:      stsRegion,
+			}
+			return
+		}
+		envCredentials = StaticCredentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+	})
+	return envCredentials
+}
+
+// signV4 signs req with AWS Signature Version 4. It signs the host, the Content-Type
+// and all X-Amz-* headers; headers added later, like the trace context, are not signed.
+func signV4(req *http.Request, body []byte, creds AWSCredentials, region, service string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes the query with sorted keys and values, escaping spaces as %20.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var parts []string
+	for _, key := range keys {
+		values := slices.Clone(query[key])
+		slices.Sort(values)
+		for _, value := range values {
+			parts = append(parts, awsEscape(key)+"="+awsEscape(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func awsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
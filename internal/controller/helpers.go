@@ -20,19 +20,274 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
 	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
 )
 
+// tracer creates the reconcile and Secret loading spans. It uses the global
+// TracerProvider, which is a no-op unless tracing is enabled in cmd/main.go.
+var tracer = otel.Tracer("github.com/honza/schema-strimzi-operator/internal/controller")
+
+// endSpan records err on the span, if any, and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// registryRefAttribute returns the "<namespace>/<name>" span attribute of a registry reference.
+func registryRefAttribute(namespace string, ref registryv1alpha1.SchemaRegistryRef) attribute.KeyValue {
+	return attribute.String("schemaregistry", registryRefKey(namespace, ref))
+}
+
+// registryRefKey returns "<namespace>/<name>" of a registry reference, resolving an
+// empty namespace to the namespace of the referencing object.
+func registryRefKey(namespace string, ref registryv1alpha1.SchemaRegistryRef) string {
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	return namespace + "/" + ref.Name
+}
+
+// SchemaRegistryRefs returns the registries of the Schema: spec.registryRefs, or spec.registryRef
+// when registryRefs is empty. The first one is the primary registry.
+// It is exported for the kubectl-schema plugin, which inspects the primary registry.
+func SchemaRegistryRefs(schema *registryv1alpha1.Schema) []registryv1alpha1.SchemaRegistryRef {
+	if len(schema.Spec.RegistryRefs) > 0 {
+		return schema.Spec.RegistryRefs
+	}
+	return []registryv1alpha1.SchemaRegistryRef{schema.Spec.RegistryRef}
+}
+
+// CurrentSchema returns the current schema definition of the Schema: spec.schema, or the last
+// entry of spec.versions when the history is listed.
+// It is exported for the CLIs, which compare the current schema with the registry.
+func CurrentSchema(schema *registryv1alpha1.Schema) string {
+	if n := len(schema.Spec.Versions); n > 0 {
+		return schema.Spec.Versions[n-1].Schema
+	}
+	return schema.Spec.Schema
+}
+
+// BuildRegistryClient constructs a Schema Registry HTTP client from the SchemaRegistry CR
+// referenced by ref. An empty ref namespace resolves to the namespace of the referencing object.
+// It is exported for the kubectl-schema plugin, which connects exactly like the controllers.
+func BuildRegistryClient(ctx context.Context, k8sClient client.Client, namespace string, ref registryv1alpha1.SchemaRegistryRef) (schemaclient.Registry, error) {
+	registryNamespace := ref.Namespace
+	if registryNamespace == "" {
+		registryNamespace = namespace
+	}
+
+	var schemaRegistry registryv1alpha1.SchemaRegistry
+	if err := k8sClient.Get(ctx, client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: registryNamespace,
+	}, &schemaRegistry); err != nil {
+		return nil, fmt.Errorf("failed to get SchemaRegistry %q: %w", ref.Name, err)
+	}
+
+	return newRegistryClient(ctx, k8sClient, &schemaRegistry)
+}
+
+// newRegistryClient builds the registry client for the flavor of the SchemaRegistry spec,
+// with the credentials and CA bundles in its referenced Secrets and ConfigMaps.
+func newRegistryClient(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (schemaclient.Registry, error) {
+	authConfig, err := loadAuthConfig(ctx, k8sClient, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := loadTLSConfig(ctx, k8sClient, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyConfig, err := loadProxyConfig(ctx, k8sClient, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := loadHeaders(ctx, k8sClient, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	glueOptions, err := loadGlueOptions(ctx, k8sClient, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := registryOptions(sr, tlsConfig, proxyConfig, headers, glueOptions)
+	if sr.Spec.FailoverStrategy == registryv1alpha1.FailoverStrategyRoundRobin {
+		opts.RoundRobinCounter = roundRobinCounter(sr)
+	}
+	return schemaclient.New(string(sr.Spec.Flavor), registryURLs(sr), authConfig, opts)
+}
+
+// roundRobinCounters holds the RoundRobin rotation of each SchemaRegistry by namespaced
+// name. The controllers build a new client on every reconcile, so the rotation has to
+// outlive the client. The SchemaRegistry reconciler forgets the rotation of a deleted
+// registry, and a recreated registry (new UID) starts over.
+var roundRobinCounters sync.Map
+
+// roundRobinRotation is the rotation of one SchemaRegistry incarnation.
+type roundRobinRotation struct {
+	uid     types.UID
+	counter *atomic.Uint32
+}
+
+// roundRobinCounter returns the rotation shared by all clients of the SchemaRegistry.
+func roundRobinCounter(sr *registryv1alpha1.SchemaRegistry) *atomic.Uint32 {
+	key := types.NamespacedName{Namespace: sr.Namespace, Name: sr.Name}
+	for {
+		fresh := &roundRobinRotation{uid: sr.UID, counter: new(atomic.Uint32)}
+		value, loaded := roundRobinCounters.LoadOrStore(key, fresh)
+		rotation := value.(*roundRobinRotation)
+		if !loaded || rotation.uid == sr.UID {
+			return rotation.counter
+		}
+		if roundRobinCounters.CompareAndSwap(key, rotation, fresh) {
+			return fresh.counter
+		}
+	}
+}
+
+// forgetRoundRobinCounter drops the rotation of a deleted SchemaRegistry.
+func forgetRoundRobinCounter(key types.NamespacedName) {
+	roundRobinCounters.Delete(key)
+}
+
+// registryOptions assembles the client options of the SchemaRegistry from the loaded
+// TLS, proxy, header and Glue settings.
+func registryOptions(sr *registryv1alpha1.SchemaRegistry, tlsConfig schemaclient.TLSConfig, proxyConfig *schemaclient.ProxyConfig,
+	headers http.Header, glueOptions schemaclient.GlueOptions) schemaclient.Options {
+	opts := schemaclient.Options{
+		Timeout:          registryTimeout(sr),
+		TLS:              tlsConfig,
+		Proxy:            proxyConfig,
+		Headers:          headers,
+		FailoverStrategy: string(sr.Spec.FailoverStrategy),
+		DetectedFlavor:   sr.Status.DetectedFlavor,
+		Glue:             glueOptions,
+	}
+	if sr.Spec.Apicurio != nil {
+		opts.Apicurio = schemaclient.ApicurioOptions{
+			GroupID: sr.Spec.Apicurio.GroupID,
+			Labels:  sr.Spec.Apicurio.Labels,
+		}
+	}
+	return opts
+}
+
+// registryURLs returns the endpoints of the SchemaRegistry: spec.urls, or spec.url when urls is empty.
+func registryURLs(sr *registryv1alpha1.SchemaRegistry) []string {
+	if len(sr.Spec.URLs) > 0 {
+		return sr.Spec.URLs
+	}
+	if sr.Spec.URL != "" {
+		return []string{sr.Spec.URL}
+	}
+	return nil
+}
+
+// errImportMode is returned by importSchema when the subject cannot be switched to IMPORT mode.
+var errImportMode = errors.New("failed to switch subject to IMPORT mode")
+
+// importSchema registers the schema under subject with the given schema ID. The subject is
+// switched to IMPORT mode for the registration and afterwards returns to mode, or to the
+// global mode when mode is empty. The registry does not check compatibility in IMPORT mode.
+func importSchema(ctx context.Context, srClient schemaclient.Registry, subject string,
+	request schemaclient.RegisterSchemaRequest, id int, mode string) (*schemaclient.SchemaResponse, error) {
+	if err := srClient.SetSubjectMode(ctx, subject, "IMPORT"); err != nil {
+		return nil, fmt.Errorf("%w: %w", errImportMode, err)
+	}
+	defer func() {
+		// The subject mode is restored whether or not the registration succeeded
+		var err error
+		if mode != "" {
+			err = srClient.SetSubjectMode(ctx, subject, mode)
+		} else {
+			err = srClient.DeleteSubjectMode(ctx, subject)
+		}
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to reset subject mode", "subject", subject)
+		}
+	}()
+
+	request.ID = id
+	return srClient.RegisterSchema(ctx, subject, request)
+}
+
+// readyConditionChanged reports whether setting the Ready condition to False with
+// reason would change it, i.e. the reason differs or a new generation is observed.
+// It keeps periodic requeues from repeating the same Warning event.
+func readyConditionChanged(conditions []metav1.Condition, reason string, generation int64) bool {
+	ready := meta.FindStatusCondition(conditions, "Ready")
+	return ready == nil ||
+		ready.Status != metav1.ConditionFalse ||
+		ready.Reason != reason ||
+		ready.ObservedGeneration != generation
+}
+
+// updateSuspendedCondition records spec.suspend in the Suspended condition and reports
+// whether the condition changed. Objects that were never suspended get no condition.
+func updateSuspendedCondition(conditions *[]metav1.Condition, suspend bool, generation int64) bool {
+	if !suspend && meta.FindStatusCondition(*conditions, "Suspended") == nil {
+		return false
+	}
+	condition := metav1.Condition{
+		Type:               "Suspended",
+		Status:             metav1.ConditionFalse,
+		Reason:             "Resumed",
+		Message:            "Reconciliation is active",
+		ObservedGeneration: generation,
+	}
+	if suspend {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Suspended"
+		condition.Message = "Reconciliation is suspended by spec.suspend"
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// registryTimeout returns the request timeout configured on the SchemaRegistry, defaulting to 30s.
+func registryTimeout(sr *registryv1alpha1.SchemaRegistry) time.Duration {
+	timeout := time.Duration(sr.Spec.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	return timeout
+}
+
 // loadAuthConfig reads authentication credentials from referenced Kubernetes Secrets
 // and builds an AuthConfig for the Schema Registry HTTP client.
-func loadAuthConfig(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (schemaclient.AuthConfig, error) {
-	authConfig := schemaclient.AuthConfig{
+func loadAuthConfig(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (authConfig schemaclient.AuthConfig, err error) {
+	ctx, span := tracer.Start(ctx, "loadAuthConfig")
+	defer func() { endSpan(span, err) }()
+
+	authConfig = schemaclient.AuthConfig{
 		Type: "NONE",
 	}
 
@@ -42,71 +297,381 @@ func loadAuthConfig(ctx context.Context, k8sClient client.Client, sr *registryv1
 
 	authConfig.Type = string(sr.Spec.Auth.Type)
 
+	if sr.Spec.Auth.KafkaUserRef != nil {
+		return loadKafkaUserAuthConfig(ctx, k8sClient, sr, authConfig)
+	}
+
 	switch sr.Spec.Auth.Type {
 	case registryv1alpha1.AuthTypeBasic:
 		if sr.Spec.Auth.BasicAuth == nil {
 			return authConfig, fmt.Errorf("basicAuth config is required when type is BASIC")
 		}
+		ref := sr.Spec.Auth.BasicAuth.SecretRef
 
 		secret := &corev1.Secret{}
 		if err := k8sClient.Get(ctx, client.ObjectKey{
-			Name:      sr.Spec.Auth.BasicAuth.SecretRef,
+			Name:      ref.Name,
 			Namespace: sr.Namespace,
 		}, secret); err != nil {
-			return authConfig, fmt.Errorf("failed to get basic auth secret %q: %w", sr.Spec.Auth.BasicAuth.SecretRef, err)
+			return authConfig, fmt.Errorf("failed to get basic auth secret %q: %w", ref.Name, err)
+		}
+
+		username, err := secretValue(secret, keyOrDefault(ref.UsernameKey, "username"))
+		if err != nil {
+			return authConfig, err
+		}
+		password, err := secretValue(secret, keyOrDefault(ref.PasswordKey, "password"))
+		if err != nil {
+			return authConfig, err
 		}
 
-		authConfig.Username = string(secret.Data["username"])
-		authConfig.Password = string(secret.Data["password"])
+		authConfig.Username = string(username)
+		authConfig.Password = string(password)
 
 	case registryv1alpha1.AuthTypeBearer:
 		if sr.Spec.Auth.BearerAuth == nil {
 			return authConfig, fmt.Errorf("bearerAuth config is required when type is BEARER")
 		}
+		ref := sr.Spec.Auth.BearerAuth.SecretRef
 
 		secret := &corev1.Secret{}
 		if err := k8sClient.Get(ctx, client.ObjectKey{
-			Name:      sr.Spec.Auth.BearerAuth.SecretRef,
+			Name:      ref.Name,
 			Namespace: sr.Namespace,
 		}, secret); err != nil {
-			return authConfig, fmt.Errorf("failed to get bearer auth secret %q: %w", sr.Spec.Auth.BearerAuth.SecretRef, err)
+			return authConfig, fmt.Errorf("failed to get bearer auth secret %q: %w", ref.Name, err)
+		}
+
+		token, err := secretValue(secret, keyOrDefault(ref.TokenKey, "token"))
+		if err != nil {
+			return authConfig, err
 		}
 
-		authConfig.BearerToken = string(secret.Data["token"])
+		authConfig.BearerToken = string(token)
 
 	case registryv1alpha1.AuthTypeMTLS:
 		if sr.Spec.Auth.MTLS == nil {
 			return authConfig, fmt.Errorf("mtls config is required when type is MTLS")
 		}
+		ref := sr.Spec.Auth.MTLS.CertSecretRef
 
 		certSecret := &corev1.Secret{}
 		if err := k8sClient.Get(ctx, client.ObjectKey{
-			Name:      sr.Spec.Auth.MTLS.CertSecretRef,
+			Name:      ref.Name,
 			Namespace: sr.Namespace,
 		}, certSecret); err != nil {
-			return authConfig, fmt.Errorf("failed to get client cert secret %q: %w", sr.Spec.Auth.MTLS.CertSecretRef, err)
+			return authConfig, fmt.Errorf("failed to get client cert secret %q: %w", ref.Name, err)
 		}
 
-		cert, err := tls.X509KeyPair(certSecret.Data["tls.crt"], certSecret.Data["tls.key"])
+		certPEM, err := secretValue(certSecret, keyOrDefault(ref.CertKey, "tls.crt"))
+		if err != nil {
+			return authConfig, err
+		}
+		keyPEM, err := secretValue(certSecret, keyOrDefault(ref.KeyKey, "tls.key"))
+		if err != nil {
+			return authConfig, err
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
 		if err != nil {
 			return authConfig, fmt.Errorf("failed to parse client certificate: %w", err)
 		}
 
 		authConfig.ClientCert = cert
+	}
+
+	return authConfig, nil
+}
+
+// loadTLSConfig builds the client TLS settings from spec.tls. CA bundles from spec.tls.ca,
+// the MTLS caSecretRef and the KafkaUser cluster CA are combined into one pool, and any
+// PEM data that fails to parse is reported as an error.
+func loadTLSConfig(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (tlsConfig schemaclient.TLSConfig, err error) {
+	ctx, span := tracer.Start(ctx, "loadTLSConfig")
+	defer func() { endSpan(span, err) }()
+
+	tlsConfig = schemaclient.TLSConfig{
+		InsecureSkipVerify: sr.Spec.InsecureSkipVerify,
+	}
+
+	var caSources []*registryv1alpha1.CABundleSource
+	if sr.Spec.TLS != nil {
+		tlsConfig.ServerName = sr.Spec.TLS.ServerName
+		tlsConfig.MinVersion = string(sr.Spec.TLS.MinVersion)
+		tlsConfig.CipherSuites = sr.Spec.TLS.CipherSuites
+		if sr.Spec.TLS.CA != nil {
+			caSources = append(caSources, sr.Spec.TLS.CA)
+		}
+	}
+	if sr.Spec.Auth != nil {
+		if sr.Spec.Auth.KafkaUserRef != nil && sr.Spec.Auth.KafkaUserRef.ClusterCASecretRef != "" {
+			caSources = append(caSources, &registryv1alpha1.CABundleSource{
+				SecretRef: &registryv1alpha1.CASecretRef{Name: sr.Spec.Auth.KafkaUserRef.ClusterCASecretRef},
+			})
+		}
+		if sr.Spec.Auth.Type == registryv1alpha1.AuthTypeMTLS && sr.Spec.Auth.MTLS != nil && sr.Spec.Auth.MTLS.CASecretRef != nil {
+			caSources = append(caSources, &registryv1alpha1.CABundleSource{SecretRef: sr.Spec.Auth.MTLS.CASecretRef})
+		}
+	}
+
+	if len(caSources) == 0 {
+		return tlsConfig, nil
+	}
+
+	caCertPool := x509.NewCertPool()
+	for _, source := range caSources {
+		caPEM, origin, err := loadCABundle(ctx, k8sClient, sr.Namespace, source)
+		if err != nil {
+			return tlsConfig, err
+		}
+		if err := schemaclient.AppendCertsFromPEM(caCertPool, caPEM); err != nil {
+			return tlsConfig, fmt.Errorf("invalid CA bundle in %s: %w", origin, err)
+		}
+	}
+	tlsConfig.RootCAs = caCertPool
+
+	return tlsConfig, nil
+}
+
+// loadProxyConfig builds the client proxy settings from spec.proxy, reading the
+// proxy credentials from the referenced Secret when set.
+func loadProxyConfig(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (proxyConfig *schemaclient.ProxyConfig, err error) {
+	ctx, span := tracer.Start(ctx, "loadProxyConfig")
+	defer func() { endSpan(span, err) }()
+
+	if sr.Spec.Proxy == nil {
+		return nil, nil
+	}
+
+	proxyConfig = &schemaclient.ProxyConfig{
+		URL:     sr.Spec.Proxy.URL,
+		NoProxy: sr.Spec.Proxy.NoProxy,
+	}
+
+	if ref := sr.Spec.Proxy.AuthSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{
+			Name:      ref.Name,
+			Namespace: sr.Namespace,
+		}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get proxy auth secret %q: %w", ref.Name, err)
+		}
+
+		username, err := secretValue(secret, keyOrDefault(ref.UsernameKey, "username"))
+		if err != nil {
+			return nil, err
+		}
+		password, err := secretValue(secret, keyOrDefault(ref.PasswordKey, "password"))
+		if err != nil {
+			return nil, err
+		}
+
+		proxyConfig.Username = string(username)
+		proxyConfig.Password = string(password)
+	}
+
+	return proxyConfig, nil
+}
+
+// loadHeaders resolves spec.headers into the extra headers sent with every request.
+func loadHeaders(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (headers http.Header, err error) {
+	ctx, span := tracer.Start(ctx, "loadHeaders")
+	defer func() { endSpan(span, err) }()
+
+	if len(sr.Spec.Headers) == 0 {
+		return nil, nil
+	}
+
+	headers = http.Header{}
+	for _, header := range sr.Spec.Headers {
+		if header.ValueFrom == nil {
+			headers.Set(header.Name, header.Value)
+			continue
+		}
+
+		ref := header.ValueFrom.SecretKeyRef
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{
+			Name:      ref.Name,
+			Namespace: sr.Namespace,
+		}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get secret %q for header %q: %w", ref.Name, header.Name, err)
+		}
+
+		value, err := secretValue(secret, ref.Key)
+		if err != nil {
+			return nil, err
+		}
+		headers.Set(header.Name, strings.TrimSpace(string(value)))
+	}
+
+	return headers, nil
+}
+
+// loadGlueOptions builds the Glue settings from spec.glue, reading the IAM access keys from
+// the referenced Secret when set. Without a Secret the client uses the operator credentials.
+func loadGlueOptions(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry) (glueOptions schemaclient.GlueOptions, err error) {
+	ctx, span := tracer.Start(ctx, "loadGlueOptions")
+	defer func() { endSpan(span, err) }()
+
+	if sr.Spec.Glue == nil {
+		return schemaclient.GlueOptions{}, nil
+	}
+
+	glueOptions = schemaclient.GlueOptions{
+		Region:       sr.Spec.Glue.Region,
+		RegistryName: sr.Spec.Glue.RegistryName,
+	}
+
+	if ref := sr.Spec.Glue.CredentialsSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{
+			Name:      ref.Name,
+			Namespace: sr.Namespace,
+		}, secret); err != nil {
+			return glueOptions, fmt.Errorf("failed to get AWS credentials secret %q: %w", ref.Name, err)
+		}
+
+		accessKeyID, err := secretValue(secret, keyOrDefault(ref.AccessKeyIDKey, "accessKeyId"))
+		if err != nil {
+			return glueOptions, err
+		}
+		secretAccessKey, err := secretValue(secret, keyOrDefault(ref.SecretAccessKeyKey, "secretAccessKey"))
+		if err != nil {
+			return glueOptions, err
+		}
+
+		glueOptions.Credentials = schemaclient.StaticCredentials{
+			AccessKeyID:     strings.TrimSpace(string(accessKeyID)),
+			SecretAccessKey: strings.TrimSpace(string(secretAccessKey)),
+			// The session token is optional, unlike the access keys
+			SessionToken: strings.TrimSpace(string(secret.Data[keyOrDefault(ref.SessionTokenKey, "sessionToken")])),
+		}
+	}
+
+	return glueOptions, nil
+}
+
+// loadCABundle reads the PEM data selected by a CABundleSource. It also returns a
+// description of where the data came from for error messages.
+func loadCABundle(ctx context.Context, k8sClient client.Client, namespace string, source *registryv1alpha1.CABundleSource) ([]byte, string, error) {
+	switch {
+	case source.SecretRef != nil:
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{
+			Name:      source.SecretRef.Name,
+			Namespace: namespace,
+		}, secret); err != nil {
+			return nil, "", fmt.Errorf("failed to get CA cert secret %q: %w", source.SecretRef.Name, err)
+		}
+		key := keyOrDefault(source.SecretRef.Key, "ca.crt")
+		caPEM, err := secretValue(secret, key)
+		return caPEM, fmt.Sprintf("secret %q key %q", secret.Name, key), err
+
+	case source.ConfigMapRef != nil:
+		configMap := &corev1.ConfigMap{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{
+			Name:      source.ConfigMapRef.Name,
+			Namespace: namespace,
+		}, configMap); err != nil {
+			return nil, "", fmt.Errorf("failed to get CA configmap %q: %w", source.ConfigMapRef.Name, err)
+		}
+		key := keyOrDefault(source.ConfigMapRef.Key, "ca.crt")
+		origin := fmt.Sprintf("configmap %q key %q", configMap.Name, key)
+		if value, ok := configMap.Data[key]; ok && value != "" {
+			return []byte(value), origin, nil
+		}
+		if value, ok := configMap.BinaryData[key]; ok && len(value) > 0 {
+			return value, origin, nil
+		}
+		return nil, "", fmt.Errorf("configmap %q has no key %q or it is empty", configMap.Name, key)
+	}
+
+	return nil, "", fmt.Errorf("CA bundle must reference a secret or a configmap")
+}
+
+// secretValue returns the value stored under key in the Secret. A missing or empty
+// key is an error, so a misconfigured selector is reported instead of sending empty
+// credentials to the registry.
+func secretValue(secret *corev1.Secret, key string) ([]byte, error) {
+	value, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %q has no key %q", secret.Name, key)
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("key %q in secret %q is empty", key, secret.Name)
+	}
+	return value, nil
+}
+
+// clientBuildFailedReason returns the Ready condition reason of a failed BuildRegistryClient:
+// AuthSecretMissing when a referenced Secret does not exist, ClientBuildFailed otherwise.
+func clientBuildFailedReason(err error) string {
+	if isSecretNotFound(err) {
+		return "AuthSecretMissing"
+	}
+	return "ClientBuildFailed"
+}
+
+// isSecretNotFound reports whether err is caused by a referenced Secret that does not exist,
+// as opposed to a missing SchemaRegistry or ConfigMap.
+func isSecretNotFound(err error) bool {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) || !apierrors.IsNotFound(err) {
+		return false
+	}
+	details := status.Status().Details
+	return details != nil && details.Kind == "secrets"
+}
+
+// keyOrDefault returns key, or def when no key override is set.
+func keyOrDefault(key, def string) string {
+	if key == "" {
+		return def
+	}
+	return key
+}
+
+// loadKafkaUserAuthConfig builds an AuthConfig from the KafkaUser Secret written by the
+// Strimzi User Operator (password or user.crt/user.key). The cluster CA is loaded by loadTLSConfig.
+func loadKafkaUserAuthConfig(ctx context.Context, k8sClient client.Client, sr *registryv1alpha1.SchemaRegistry, authConfig schemaclient.AuthConfig) (schemaclient.AuthConfig, error) {
+	kafkaUser := sr.Spec.Auth.KafkaUserRef
+
+	userSecret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{
+		Name:      kafkaUser.Name,
+		Namespace: sr.Namespace,
+	}, userSecret); err != nil {
+		return authConfig, fmt.Errorf("failed to get KafkaUser secret %q: %w", kafkaUser.Name, err)
+	}
 
-		if sr.Spec.Auth.MTLS.CASecretRef != "" {
-			caSecret := &corev1.Secret{}
-			if err := k8sClient.Get(ctx, client.ObjectKey{
-				Name:      sr.Spec.Auth.MTLS.CASecretRef,
-				Namespace: sr.Namespace,
-			}, caSecret); err != nil {
-				return authConfig, fmt.Errorf("failed to get CA cert secret %q: %w", sr.Spec.Auth.MTLS.CASecretRef, err)
-			}
+	switch sr.Spec.Auth.Type {
+	case registryv1alpha1.AuthTypeBasic:
+		// SCRAM users authenticate with the KafkaUser name as username
+		password, err := secretValue(userSecret, "password")
+		if err != nil {
+			return authConfig, err
+		}
+		authConfig.Username = kafkaUser.Name
+		authConfig.Password = string(password)
 
-			caCertPool := x509.NewCertPool()
-			caCertPool.AppendCertsFromPEM(caSecret.Data["ca.crt"])
-			authConfig.CACert = caCertPool
+	case registryv1alpha1.AuthTypeMTLS:
+		certPEM, err := secretValue(userSecret, "user.crt")
+		if err != nil {
+			return authConfig, err
 		}
+		keyPEM, err := secretValue(userSecret, "user.key")
+		if err != nil {
+			return authConfig, err
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return authConfig, fmt.Errorf("failed to parse KafkaUser client certificate: %w", err)
+		}
+		authConfig.ClientCert = cert
+
+	default:
+		return authConfig, fmt.Errorf("kafkaUserRef is not supported with auth type %s", sr.Spec.Auth.Type)
 	}
 
 	return authConfig, nil
@@ -18,13 +18,20 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -34,13 +41,15 @@ import (
 
 	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
 	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+	"github.com/honza/schema-strimzi-operator/internal/schemadiff"
 )
 
 const schemaFinalizer = "registry.strimzi.io/schema-finalizer"
 
 type SchemaReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemas,verbs=get;list;watch;create;update;patch;delete
@@ -48,25 +57,63 @@ type SchemaReconciler struct {
 // +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemas/finalizers,verbs=update
 // +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile registers the schema in Schema Registry or cleans it up when deleted.
 // A finalizer ensures the subject is removed from the registry before the CR is deleted.
+// While spec.suspend is set the registry is not contacted at all, and with
+// spec.observeOnly the existing subject is only looked up, never written.
 func (r *SchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracer.Start(ctx, "Schema.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.schema.name", req.Name),
+	))
+	defer span.End()
+
 	log := logf.FromContext(ctx)
 
 	var schema registryv1alpha1.Schema
 	if err := r.Get(ctx, req.NamespacedName, &schema); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	span.SetAttributes(
+		attribute.String("schema.subject", schema.Spec.Subject),
+		registryRefAttribute(schema.Namespace, SchemaRegistryRefs(&schema)[0]),
+	)
+
+	// --- Suspension: skip every registry call, including the finalizer cleanup ---
+	wasSuspended := meta.IsStatusConditionTrue(schema.Status.Conditions, "Suspended")
+	if updateSuspendedCondition(&schema.Status.Conditions, schema.Spec.Suspend, schema.Generation) {
+		if err := r.Status().Update(ctx, &schema); err != nil {
+			log.Error(err, "Failed to update Schema status")
+			return ctrl.Result{}, err
+		}
+		switch {
+		case schema.Spec.Suspend && !wasSuspended:
+			r.Recorder.Eventf(&schema, nil, corev1.EventTypeNormal, "Suspended", "Suspend",
+				"Reconciliation of subject %s is suspended", schema.Spec.Subject)
+		case !schema.Spec.Suspend && wasSuspended:
+			r.Recorder.Eventf(&schema, nil, corev1.EventTypeNormal, "Resumed", "Resume",
+				"Reconciliation of subject %s is resumed", schema.Spec.Subject)
+		}
+	}
+	if schema.Spec.Suspend {
+		log.Info("Schema reconciliation is suspended", "subject", schema.Spec.Subject)
+		return ctrl.Result{}, nil
+	}
 
 	// --- Deletion path ---
 	if !schema.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(&schema, schemaFinalizer) {
-			log.Info("Deleting schema subject from registry", "subject", schema.Spec.Subject)
-
-			if err := r.deleteFromRegistry(ctx, &schema); err != nil {
-				log.Error(err, "Failed to delete schema subject from registry")
-				return ctrl.Result{}, err
+			if schema.Spec.ObserveOnly {
+				log.Info("Schema is observe-only, keeping subject in registry", "subject", schema.Spec.Subject)
+			} else {
+				log.Info("Deleting schema subject from registry", "subject", schema.Spec.Subject)
+
+				if err := r.deleteFromRegistry(ctx, &schema); err != nil {
+					log.Error(err, "Failed to delete schema subject from registry")
+					return ctrl.Result{}, err
+				}
 			}
 
 			controllerutil.RemoveFinalizer(&schema, schemaFinalizer)
@@ -89,33 +136,61 @@ func (r *SchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 	}
 
+	registerReq := schemaclient.RegisterSchemaRequest{
+		Schema:     schema.Spec.Schema,
+		SchemaType: string(schema.Spec.SchemaType),
+		References: convertReferences(schema.Spec.References),
+	}
+
+	// --- Several registries: register into each of them ---
+	if len(schema.Spec.RegistryRefs) > 0 {
+		return r.reconcileRegistries(ctx, &schema, registerReq)
+	}
+
 	// --- Build Schema Registry client ---
 	srClient, err := r.buildClient(ctx, &schema)
 	if err != nil {
 		log.Error(err, "Failed to build Schema Registry client")
-		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &schema, "ClientBuildFailed", err.Error())
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &schema, clientBuildFailedReason(err), err.Error())
 	}
 
 	// --- Register schema ---
-	registerReq := schemaclient.RegisterSchemaRequest{
-		Schema:     schema.Spec.Schema,
-		SchemaType: string(schema.Spec.SchemaType),
-		References: convertReferences(schema.Spec.References),
+	if schema.Spec.ObserveOnly {
+		return r.observe(ctx, &schema, srClient, registerReq)
+	}
+
+	// --- Register the history of spec.versions ---
+	if len(schema.Spec.Versions) > 0 {
+		return r.reconcileVersions(ctx, &schema, srClient)
+	}
+
+	mode, reason, err := r.prepareSubjectMode(ctx, srClient, &schema, schema.Spec.SchemaID)
+	if err != nil {
+		log.Error(err, "Failed to prepare subject mode", "subject", schema.Spec.Subject, "mode", schema.Spec.Mode)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &schema, reason, err.Error())
 	}
 
 	log.Info("Registering schema", "subject", schema.Spec.Subject, "type", schema.Spec.SchemaType)
 
-	resp, err := srClient.RegisterSchema(ctx, schema.Spec.Subject, registerReq)
+	resp, reason, err := registerInRegistry(ctx, srClient, &schema, registerReq, schema.Spec.SchemaID, mode)
 	if err != nil {
 		log.Error(err, "Failed to register schema", "subject", schema.Spec.Subject)
-		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &schema, "RegistrationFailed", err.Error())
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &schema, reason, err.Error())
+	}
+
+	if err := lockSubjectMode(ctx, srClient, &schema, mode); err != nil {
+		log.Error(err, "Failed to set subject mode", "subject", schema.Spec.Subject, "mode", schema.Spec.Mode)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &schema, "ModeChangeFailed", err.Error())
 	}
 
 	// --- Set compatibility level if specified ---
+	compatibilityApplied := false
 	if schema.Spec.CompatibilityLevel != "" {
 		if err := srClient.SetCompatibility(ctx, schema.Spec.Subject, schema.Spec.CompatibilityLevel); err != nil {
 			log.Error(err, "Failed to set compatibility level", "subject", schema.Spec.Subject, "level", schema.Spec.CompatibilityLevel)
 			// Non-fatal: log but continue - schema is already registered
+		} else {
+			compatibilityApplied = true
 		}
 	}
 
@@ -125,11 +200,19 @@ func (r *SchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	r.recordRegistration(&schema, schema.Status.SchemaID, schema.Status.Version,
+		[]*schemaclient.SchemaResponse{resp}, "", compatibilityApplied)
+	r.recordSubjectMode(&schema)
+
 	now := metav1.Now()
 	schema.Status.SchemaID = &resp.ID
 	schema.Status.Version = &resp.Version
 	schema.Status.RegisteredAt = &now
 	schema.Status.ObservedGeneration = schema.Generation
+	// Left over when spec.registryRefs was replaced by a single registryRef
+	schema.Status.Registries = nil
+	// Left over when spec.versions was replaced by spec.schema
+	schema.Status.Versions = nil
 
 	meta.SetStatusCondition(&schema.Status.Conditions, metav1.Condition{
 		Type:               "Ready",
@@ -148,58 +231,655 @@ func (r *SchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	return ctrl.Result{}, nil
 }
 
-// buildClient constructs a Schema Registry HTTP client from the referenced SchemaRegistry CR.
-func (r *SchemaReconciler) buildClient(ctx context.Context, schema *registryv1alpha1.Schema) (*schemaclient.SchemaRegistryClient, error) {
-	registryNamespace := schema.Spec.RegistryRef.Namespace
-	if registryNamespace == "" {
-		registryNamespace = schema.Namespace
+// observe looks up the version of the subject that holds the schema and records it in
+// the status without writing anything to the registry, so existing subjects can be adopted.
+func (r *SchemaReconciler) observe(ctx context.Context, schema *registryv1alpha1.Schema, srClient schemaclient.Registry, request schemaclient.RegisterSchemaRequest) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Looking up schema", "subject", schema.Spec.Subject, "type", schema.Spec.SchemaType)
+
+	resp, reason, err := lookupObserved(ctx, srClient, schema.Spec.Subject, request)
+	if err != nil {
+		log.Error(err, "Failed to look up schema", "subject", schema.Spec.Subject, "reason", reason)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, schema, reason, err.Error())
 	}
 
-	var schemaRegistry registryv1alpha1.SchemaRegistry
-	if err := r.Get(ctx, client.ObjectKey{
-		Name:      schema.Spec.RegistryRef.Name,
-		Namespace: registryNamespace,
-	}, &schemaRegistry); err != nil {
-		return nil, fmt.Errorf("failed to get SchemaRegistry %q: %w", schema.Spec.RegistryRef.Name, err)
+	// Re-fetch before status update to avoid conflicts
+	if err := r.Get(ctx, client.ObjectKeyFromObject(schema), schema); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	r.recordRegistration(schema, schema.Status.SchemaID, schema.Status.Version,
+		[]*schemaclient.SchemaResponse{resp}, "", false)
+
+	schema.Status.SchemaID = &resp.ID
+	schema.Status.Version = &resp.Version
+	schema.Status.ObservedGeneration = schema.Generation
+
+	meta.SetStatusCondition(&schema.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Observed",
+		Message:            fmt.Sprintf("Schema found with ID %d, version %d", resp.ID, resp.Version),
+		ObservedGeneration: schema.Generation,
+	})
+
+	if err := r.Status().Update(ctx, schema); err != nil {
+		log.Error(err, "Failed to update Schema status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Schema found in registry", "subject", schema.Spec.Subject, "schemaID", resp.ID, "version", resp.Version)
+	return ctrl.Result{}, nil
+}
+
+// reconcileVersions registers the entries of spec.versions in order, so the history of the
+// subject can be replayed into an empty registry. Entries already in the subject are only
+// looked up. The first failing entry, including a version number that differs from the pinned
+// one, stops the later entries. The last entry provides status.schemaId and status.version.
+// The compatibility level is applied first, since the history may only be valid under it.
+func (r *SchemaReconciler) reconcileVersions(ctx context.Context, schema *registryv1alpha1.Schema, srClient schemaclient.Registry) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	compatibilityApplied := false
+	if schema.Spec.CompatibilityLevel != "" {
+		if err := srClient.SetCompatibility(ctx, schema.Spec.Subject, schema.Spec.CompatibilityLevel); err != nil {
+			// Non-fatal: the registration reports entries the current level rejects
+			log.Error(err, "Failed to set compatibility level", "subject", schema.Spec.Subject, "level", schema.Spec.CompatibilityLevel)
+		} else {
+			compatibilityApplied = true
+		}
 	}
 
-	authConfig, err := loadAuthConfig(ctx, r.Client, &schemaRegistry)
+	mode, reason, err := r.prepareSubjectMode(ctx, srClient, schema, 0)
 	if err != nil {
-		return nil, err
+		log.Error(err, "Failed to prepare subject mode", "subject", schema.Spec.Subject, "mode", schema.Spec.Mode)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, schema, reason, err.Error())
 	}
 
-	timeout := time.Duration(schemaRegistry.Spec.Timeout) * time.Second
-	if timeout == 0 {
-		timeout = 30 * time.Second
+	log.Info("Registering schema versions", "subject", schema.Spec.Subject, "type", schema.Spec.SchemaType, "count", len(schema.Spec.Versions))
+
+	statuses := make([]registryv1alpha1.SchemaVersionStatus, len(schema.Spec.Versions))
+	var registered []*schemaclient.SchemaResponse
+	for i, entry := range schema.Spec.Versions {
+		request := schemaclient.RegisterSchemaRequest{
+			Schema:     entry.Schema,
+			SchemaType: string(schema.Spec.SchemaType),
+			References: convertReferences(schema.Spec.References),
+		}
+		resp, created, reason, err := registerVersion(ctx, srClient, schema.Spec.Subject, request, entry.Version, mode)
+		if err != nil {
+			log.Error(err, "Failed to register schema version", "subject", schema.Spec.Subject, "entry", i)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, schema, reason,
+				fmt.Sprintf("spec.versions[%d]: %s", i, err.Error()))
+		}
+		statuses[i] = registryv1alpha1.SchemaVersionStatus{Version: resp.Version, SchemaID: resp.ID}
+		if created {
+			registered = append(registered, resp)
+		}
 	}
 
-	return schemaclient.NewClient(
-		schemaRegistry.Spec.URL,
-		authConfig,
-		timeout,
-		schemaRegistry.Spec.InsecureSkipVerify,
-	)
+	if err := lockSubjectMode(ctx, srClient, schema, mode); err != nil {
+		log.Error(err, "Failed to set subject mode", "subject", schema.Spec.Subject, "mode", schema.Spec.Mode)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, schema, "ModeChangeFailed", err.Error())
+	}
+
+	// Re-fetch before status update to avoid conflicts
+	if err := r.Get(ctx, client.ObjectKeyFromObject(schema), schema); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	r.recordRegistration(schema, schema.Status.SchemaID, schema.Status.Version, registered, "", compatibilityApplied)
+	r.recordSubjectMode(schema)
+
+	latest := statuses[len(statuses)-1]
+	now := metav1.Now()
+	schema.Status.SchemaID = &latest.SchemaID
+	schema.Status.Version = &latest.Version
+	schema.Status.Versions = statuses
+	schema.Status.RegisteredAt = &now
+	schema.Status.ObservedGeneration = schema.Generation
+	schema.Status.Registries = nil
+
+	meta.SetStatusCondition(&schema.Status.Conditions, metav1.Condition{
+		Type:   "Ready",
+		Status: metav1.ConditionTrue,
+		Reason: "Registered",
+		Message: fmt.Sprintf("%d schema versions registered, the latest with ID %d, version %d",
+			len(statuses), latest.SchemaID, latest.Version),
+		ObservedGeneration: schema.Generation,
+	})
+
+	if err := r.Status().Update(ctx, schema); err != nil {
+		log.Error(err, "Failed to update Schema status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Schema versions successfully registered", "subject", schema.Spec.Subject, "versions", len(statuses), "registered", len(registered))
+	return ctrl.Result{}, nil
 }
 
-// deleteFromRegistry deletes the schema subject from Schema Registry during CR deletion.
-func (r *SchemaReconciler) deleteFromRegistry(ctx context.Context, schema *registryv1alpha1.Schema) error {
-	srClient, err := r.buildClient(ctx, schema)
+// registerVersion registers one entry of spec.versions, unless the subject already holds it,
+// and verifies that the registry reports the pinned version. It reports whether the entry was
+// registered now. On failure the reason for the status is returned with the error.
+func registerVersion(ctx context.Context, srClient schemaclient.Registry, subject string,
+	request schemaclient.RegisterSchemaRequest, pinned int, mode string) (*schemaclient.SchemaResponse, bool, string, error) {
+	resp, err := srClient.LookupSchema(ctx, subject, request)
+	created := false
+	switch {
+	case err == nil:
+	case !schemaclient.IsSubjectNotFound(err) && !schemaclient.IsSchemaNotFound(err):
+		return nil, false, "LookupFailed", err
+	case schemaclient.IsReadOnlyMode(mode):
+		return nil, false, "RegistryReadOnly", fmt.Errorf("subject %s is in %s mode, the schema cannot be registered", subject, mode)
+	default:
+		resp, err = srClient.RegisterSchema(ctx, subject, request)
+		if readOnly, ok := refusedAsReadOnly(ctx, srClient, subject, err); ok {
+			return nil, false, "RegistryReadOnly", fmt.Errorf("subject %s is in %s mode, the schema cannot be registered", subject, readOnly)
+		}
+		if schemaclient.IsIncompatible(err) {
+			return nil, false, "Incompatible", err
+		}
+		if err != nil {
+			return nil, false, "RegistrationFailed", err
+		}
+		created = true
+	}
+
+	if pinned != 0 && resp.Version != pinned {
+		if created {
+			return nil, false, "VersionMismatch", fmt.Errorf("registry assigned version %d instead of the pinned version %d", resp.Version, pinned)
+		}
+		return nil, false, "VersionMismatch", fmt.Errorf("schema is registered as version %d instead of %d", resp.Version, pinned)
+	}
+	return resp, created, "", nil
+}
+
+// registryResult is the outcome of reconciling the schema in one registry of spec.registryRefs.
+type registryResult struct {
+	// key is the "<namespace>/<name>" of the SchemaRegistry
+	key  string
+	resp *schemaclient.SchemaResponse
+	// reason and err describe the failure when resp is nil
+	reason               string
+	err                  error
+	compatibilityApplied bool
+}
+
+// reconcileRegistries registers the schema into every registry of spec.registryRefs, or looks it
+// up with spec.observeOnly, and reports each registry in status.registries. The first registry is
+// the primary one and provides status.schemaId and status.version. Every registry imports the
+// schema under spec.schemaId when set. With spec.sameSchemaId the other registries import the
+// schema under the ID assigned by the primary registry.
+func (r *SchemaReconciler) reconcileRegistries(ctx context.Context, schema *registryv1alpha1.Schema, request schemaclient.RegisterSchemaRequest) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	results := make([]registryResult, len(schema.Spec.RegistryRefs))
+	for i, ref := range schema.Spec.RegistryRefs {
+		result := &results[i]
+		result.key = registryRefKey(schema.Namespace, ref)
+
+		srClient, err := BuildRegistryClient(ctx, r.Client, schema.Namespace, ref)
+		if err != nil {
+			log.Error(err, "Failed to build Schema Registry client", "registry", result.key)
+			result.reason, result.err = clientBuildFailedReason(err), err
+			continue
+		}
+
+		id := schema.Spec.SchemaID
+		if i > 0 && schema.Spec.SameSchemaID {
+			if results[0].resp == nil {
+				result.reason = "PrimaryNotReady"
+				result.err = fmt.Errorf("schema is not registered in the primary registry %s", results[0].key)
+				continue
+			}
+			id = results[0].resp.ID
+		}
+
+		mode := ""
+		if !schema.Spec.ObserveOnly {
+			if mode, result.reason, result.err = r.prepareSubjectMode(ctx, srClient, schema, id); result.err != nil {
+				log.Error(result.err, "Failed to prepare subject mode", "subject", schema.Spec.Subject, "registry", result.key)
+				continue
+			}
+		}
+
+		log.Info("Registering schema", "subject", schema.Spec.Subject, "registry", result.key, "id", id)
+		result.resp, result.reason, result.err = registerInRegistry(ctx, srClient, schema, request, id, mode)
+		if result.err != nil {
+			log.Error(result.err, "Failed to register schema", "subject", schema.Spec.Subject, "registry", result.key)
+			continue
+		}
+
+		if !schema.Spec.ObserveOnly {
+			if err := lockSubjectMode(ctx, srClient, schema, mode); err != nil {
+				log.Error(err, "Failed to set subject mode", "subject", schema.Spec.Subject, "registry", result.key)
+				result.resp, result.reason, result.err = nil, "ModeChangeFailed", err
+				continue
+			}
+		}
+
+		if schema.Spec.CompatibilityLevel != "" && !schema.Spec.ObserveOnly {
+			if err := srClient.SetCompatibility(ctx, schema.Spec.Subject, schema.Spec.CompatibilityLevel); err != nil {
+				// Non-fatal: log but continue - schema is already registered
+				log.Error(err, "Failed to set compatibility level", "subject", schema.Spec.Subject, "registry", result.key)
+			} else {
+				result.compatibilityApplied = true
+			}
+		}
+	}
+
+	// Re-fetch before status update to avoid conflicts
+	if err := r.Get(ctx, client.ObjectKeyFromObject(schema), schema); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	readyReason := "Registered"
+	if schema.Spec.ObserveOnly {
+		readyReason = "Observed"
+	}
+
+	previous := map[string]registryv1alpha1.RegistrySchemaStatus{}
+	for _, status := range schema.Status.Registries {
+		previous[status.Registry] = status
+	}
+
+	var failed *registryResult
+	statuses := make([]registryv1alpha1.RegistrySchemaStatus, len(results))
+	for i := range results {
+		result := &results[i]
+		status := registryv1alpha1.RegistrySchemaStatus{
+			Registry: result.key,
+			Ready:    metav1.ConditionFalse,
+			Reason:   result.reason,
+		}
+		if result.resp == nil {
+			status.Message = result.err.Error()
+			if failed == nil {
+				failed = result
+			}
+			statuses[i] = status
+			continue
+		}
+
+		status.SchemaID = &result.resp.ID
+		status.Version = &result.resp.Version
+		status.Ready = metav1.ConditionTrue
+		status.Reason = readyReason
+		status.Message = fmt.Sprintf("Schema found with ID %d, version %d", result.resp.ID, result.resp.Version)
+		if !schema.Spec.ObserveOnly {
+			status.Message = fmt.Sprintf("Schema registered with ID %d, version %d", result.resp.ID, result.resp.Version)
+		}
+		statuses[i] = status
+
+		old := previous[result.key]
+		// The primary registry provides the compatibility level in the status
+		r.recordRegistration(schema, old.SchemaID, old.Version, []*schemaclient.SchemaResponse{result.resp},
+			result.key, i == 0 && result.compatibilityApplied)
+	}
+	schema.Status.Registries = statuses
+	schema.Status.Versions = nil
+
+	if primary := results[0]; primary.resp != nil {
+		schema.Status.SchemaID = &primary.resp.ID
+		schema.Status.Version = &primary.resp.Version
+		if !schema.Spec.ObserveOnly {
+			now := metav1.Now()
+			schema.Status.RegisteredAt = &now
+		}
+	}
+	schema.Status.ObservedGeneration = schema.Generation
+
+	result := ctrl.Result{}
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             readyReason,
+		Message:            fmt.Sprintf("Schema is ready in %d registries", len(results)),
+		ObservedGeneration: schema.Generation,
+	}
+	if failed != nil {
+		message := fmt.Sprintf("Registry %s: %s", failed.key, failed.err.Error())
+		span := trace.SpanFromContext(ctx)
+		span.SetStatus(codes.Error, message)
+		span.SetAttributes(attribute.String("reason", failed.reason))
+		if readyConditionChanged(schema.Status.Conditions, failed.reason, schema.Generation) {
+			r.Recorder.Eventf(schema, nil, corev1.EventTypeWarning, failed.reason, "Reconcile", "%s", message)
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = failed.reason
+		condition.Message = message
+		result.RequeueAfter = time.Minute
+	} else {
+		r.recordSubjectMode(schema)
+	}
+	meta.SetStatusCondition(&schema.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, schema); err != nil {
+		log.Error(err, "Failed to update Schema status")
+		return ctrl.Result{}, err
+	}
+
+	return result, nil
+}
+
+// registerInRegistry registers the schema in a registry, or only looks it up with spec.observeOnly.
+// A non-zero id imports the schema under that ID: the subject is switched to IMPORT mode for the
+// registration, unless the schema is already registered under the ID, and spec.version selects
+// the version of the subject. Conflicts with the ID or version are checked before the import.
+// In a read-only mode of the subject the schema is only looked up, reporting RegistryReadOnly when it is not registered.
+// On failure the reason for the status is returned with the error.
+func registerInRegistry(ctx context.Context, srClient schemaclient.Registry, schema *registryv1alpha1.Schema,
+	request schemaclient.RegisterSchemaRequest, id int, mode string) (*schemaclient.SchemaResponse, string, error) {
+	subject := schema.Spec.Subject
+	version := schema.Spec.Version
+
+	if schema.Spec.ObserveOnly {
+		return lookupObserved(ctx, srClient, subject, request)
+	}
+
+	var lookupErr error
+	if id != 0 {
+		resp, err := srClient.LookupSchema(ctx, subject, request)
+		lookupErr = err
+		notFound := schemaclient.IsSubjectNotFound(err) || schemaclient.IsSchemaNotFound(err)
+		switch {
+		case err == nil && resp.ID != id:
+			return nil, "IDMismatch", fmt.Errorf("schema is registered with ID %d instead of %d", resp.ID, id)
+		case err == nil && version != 0 && resp.Version != version:
+			return nil, "VersionMismatch", fmt.Errorf("schema is registered as version %d instead of %d", resp.Version, version)
+		case err == nil:
+			return resp, "", nil
+		case !notFound:
+			return nil, "LookupFailed", err
+		}
+	}
+
+	if schemaclient.IsReadOnlyMode(mode) {
+		return lookupReadOnly(ctx, srClient, subject, request, mode)
+	}
+
+	if id != 0 {
+		if reason, err := checkImportConflicts(ctx, srClient, subject, request, id, version, lookupErr); err != nil {
+			return nil, reason, err
+		}
+		// Registrations in IMPORT mode skip the compatibility check, so run it first
+		compatible, err := srClient.CheckCompatibility(ctx, subject, request)
+		if err != nil {
+			return nil, "RegistrationFailed", err
+		}
+		if !compatible {
+			return nil, "Incompatible", fmt.Errorf("schema is incompatible with the latest version of subject %s", subject)
+		}
+		request.Version = version
+		// A spec.mode that accepts registrations was applied by prepareSubjectMode and is
+		// restored after the import. A read-only one is applied by lockSubjectMode afterwards.
+		restore := string(schema.Spec.Mode)
+		if schemaclient.IsReadOnlyMode(restore) {
+			restore = ""
+		}
+		resp, err := importSchema(ctx, srClient, subject, request, id, restore)
+		if errors.Is(err, errImportMode) {
+			return nil, "ImportModeFailed", err
+		}
+		if err != nil {
+			return nil, "RegistrationFailed", err
+		}
+		return resp, "", nil
+	}
+
+	resp, err := srClient.RegisterSchema(ctx, subject, request)
 	if err != nil {
-		// If the registry itself is gone, we can still proceed with finalizer removal
-		logf.FromContext(ctx).Info("Could not build client during deletion, skipping registry cleanup", "error", err.Error())
+		if readOnly, ok := refusedAsReadOnly(ctx, srClient, subject, err); ok {
+			return lookupReadOnly(ctx, srClient, subject, request, readOnly)
+		}
+		if schemaclient.IsIncompatible(err) {
+			return nil, "Incompatible", err
+		}
+		return nil, "RegistrationFailed", err
+	}
+	return resp, "", nil
+}
+
+// lookupObserved looks up the version of the subject that holds the schema for
+// spec.observeOnly. A missing subject is reported as NotFound and a subject without
+// the schema as ContentMismatch.
+func lookupObserved(ctx context.Context, srClient schemaclient.Registry, subject string,
+	request schemaclient.RegisterSchemaRequest) (*schemaclient.SchemaResponse, string, error) {
+	resp, err := srClient.LookupSchema(ctx, subject, request)
+	switch {
+	case schemaclient.IsSubjectNotFound(err):
+		return nil, "NotFound", fmt.Errorf("subject %s does not exist in Schema Registry", subject)
+	case schemaclient.IsSchemaNotFound(err):
+		return nil, "ContentMismatch", fmt.Errorf("no version of subject %s matches spec.schema", subject)
+	case err != nil:
+		return nil, "LookupFailed", err
+	}
+	return resp, "", nil
+}
+
+// lookupReadOnly only looks up the schema in a subject in a read-only mode, since the registry
+// rejects any registration there, even of a schema it already has. RegistryReadOnly is reported
+// when the schema is not registered.
+func lookupReadOnly(ctx context.Context, srClient schemaclient.Registry, subject string,
+	request schemaclient.RegisterSchemaRequest, mode string) (*schemaclient.SchemaResponse, string, error) {
+	resp, err := srClient.LookupSchema(ctx, subject, request)
+	switch {
+	case err == nil:
+		return resp, "", nil
+	case !schemaclient.IsSubjectNotFound(err) && !schemaclient.IsSchemaNotFound(err):
+		return nil, "LookupFailed", err
+	}
+	return nil, "RegistryReadOnly", fmt.Errorf("subject %s is in %s mode, the schema cannot be registered", subject, mode)
+}
+
+// refusedAsReadOnly reports whether the registry refused a registration because the subject
+// is in a read-only mode, returning the mode. The mode is only read after such a refusal,
+// so subjects without spec.mode need no mode request.
+func refusedAsReadOnly(ctx context.Context, srClient schemaclient.Registry, subject string, err error) (string, bool) {
+	if !schemaclient.IsOperationNotPermitted(err) {
+		return "", false
+	}
+	mode, modeErr := srClient.GetSubjectMode(ctx, subject)
+	if modeErr != nil || !schemaclient.IsReadOnlyMode(mode) {
+		return "", false
+	}
+	return mode, true
+}
+
+// checkImportConflicts reports IDConflict when id already holds a different schema and
+// VersionConflict when version cannot be added to the subject, so the import does not end
+// in a generic registry error. lookupErr is the error of the lookup of the schema in the
+// subject. The ID is only checked on registries that resolve IDs across subjects.
+func checkImportConflicts(ctx context.Context, srClient schemaclient.Registry, subject string,
+	request schemaclient.RegisterSchemaRequest, id, version int, lookupErr error) (string, error) {
+	if getter, ok := srClient.(schemaclient.SchemaByIDGetter); ok {
+		existing, err := getter.GetSchemaByID(ctx, id)
+		switch {
+		case schemaclient.IsSchemaNotFound(err):
+		case err != nil:
+			return "LookupFailed", err
+		case !sameSchemaContent(existing, request):
+			return "IDConflict", fmt.Errorf("schema ID %d is already used by a different schema", id)
+		}
+	}
+
+	if version == 0 || schemaclient.IsSubjectNotFound(lookupErr) {
+		return "", nil
+	}
+	versions, err := srClient.GetSubjectVersions(ctx, subject)
+	if err != nil {
+		return "LookupFailed", err
+	}
+	switch {
+	case slices.Contains(versions, version):
+		return "VersionConflict", fmt.Errorf("version %d of subject %s holds a different schema", version, subject)
+	case len(versions) > 0 && version < slices.Max(versions):
+		return "VersionConflict", fmt.Errorf("version %d is lower than the latest version %d of subject %s",
+			version, slices.Max(versions), subject)
+	}
+	return "", nil
+}
+
+// sameSchemaContent reports whether the registered schema has the type, content and
+// references of the request, ignoring JSON formatting.
+func sameSchemaContent(registered *schemaclient.SchemaResponse, request schemaclient.RegisterSchemaRequest) bool {
+	registeredType, requestType := registered.SchemaType, request.SchemaType
+	// The registry omits the type of AVRO schemas
+	if registeredType == "" {
+		registeredType = string(registryv1alpha1.SchemaTypeAvro)
+	}
+	if requestType == "" {
+		requestType = string(registryv1alpha1.SchemaTypeAvro)
+	}
+	return registeredType == requestType &&
+		len(schemadiff.Diff(requestType, registered.Schema, request.Schema)) == 0 &&
+		slices.Equal(registered.References, request.References)
+}
+
+// prepareSubjectMode returns the mode that applies to the subject before the registration.
+// The mode is only read when spec.mode is set or the schema is imported under id, which must
+// not switch a read-only subject to IMPORT. Otherwise a read-only mode is detected once the
+// registry refuses the registration. A spec.mode that accepts registrations is applied first,
+// so READWRITE unlocks a read-only subject. A read-only spec.mode is applied by lockSubjectMode
+// once the schema is registered. With spec.mode set the read only avoids needless updates, so
+// a failed read is reported as a ModeLookupFailed event and spec.mode is applied regardless.
+// On failure the reason for the status is returned with the error.
+func (r *SchemaReconciler) prepareSubjectMode(ctx context.Context, srClient schemaclient.Registry,
+	schema *registryv1alpha1.Schema, id int) (string, string, error) {
+	desired := string(schema.Spec.Mode)
+	if desired == "" && id == 0 {
+		return "", "", nil
+	}
+
+	mode, err := srClient.GetSubjectMode(ctx, schema.Spec.Subject)
+	switch {
+	case err != nil && desired == "":
+		return "", "ModeLookupFailed", err
+	case err != nil:
+		logf.FromContext(ctx).Error(err, "Failed to read subject mode", "subject", schema.Spec.Subject)
+		r.Recorder.Eventf(schema, nil, corev1.EventTypeWarning, "ModeLookupFailed", "GetMode",
+			"Failed to read the mode of subject %s, applying %s: %v", schema.Spec.Subject, desired, err)
+		mode = ""
+	}
+
+	if desired == "" || desired == mode || schemaclient.IsReadOnlyMode(desired) {
+		return mode, "", nil
+	}
+	if err := srClient.SetSubjectMode(ctx, schema.Spec.Subject, desired); err != nil {
+		return "", "ModeChangeFailed", err
+	}
+	return desired, "", nil
+}
+
+// lockSubjectMode applies a read-only spec.mode after the registration. mode is the mode
+// the subject was in, as returned by prepareSubjectMode.
+func lockSubjectMode(ctx context.Context, srClient schemaclient.Registry, schema *registryv1alpha1.Schema, mode string) error {
+	desired := string(schema.Spec.Mode)
+	if !schemaclient.IsReadOnlyMode(desired) || desired == mode {
 		return nil
 	}
+	return srClient.SetSubjectMode(ctx, schema.Spec.Subject, desired)
+}
 
-	return srClient.DeleteSubject(ctx, schema.Spec.Subject)
+// recordSubjectMode records spec.mode in the status once it is applied, emitting an event
+// when it changes.
+func (r *SchemaReconciler) recordSubjectMode(schema *registryv1alpha1.Schema) {
+	if schema.Spec.Mode == "" || schema.Status.Mode == string(schema.Spec.Mode) {
+		return
+	}
+	r.Recorder.Eventf(schema, nil, corev1.EventTypeNormal, "ModeChanged", "SetMode",
+		"Mode of subject %s set to %s", schema.Spec.Subject, schema.Spec.Mode)
+	schema.Status.Mode = string(schema.Spec.Mode)
+}
+
+// recordRegistration emits the events of a registration and records an applied compatibility
+// level in the status. previousID and previousVersion are the schema ID and version the status
+// reported before, and registered are the versions registered now, oldest first. A version is
+// reported as Registered for a new subject, NewVersion otherwise, or Adopted with spec.observeOnly.
+// Unchanged versions emit nothing, so repeated reconciles stay quiet. registry names the registry
+// of spec.registryRefs in the messages and is empty for a single registry.
+func (r *SchemaReconciler) recordRegistration(schema *registryv1alpha1.Schema, previousID, previousVersion *int,
+	registered []*schemaclient.SchemaResponse, registry string, compatibilityApplied bool) {
+	in := ""
+	if registry != "" {
+		in = " in " + registry
+	}
+	for _, resp := range registered {
+		switch {
+		case previousID != nil && *previousID == resp.ID && previousVersion != nil && *previousVersion == resp.Version:
+		case schema.Spec.ObserveOnly:
+			r.Recorder.Eventf(schema, nil, corev1.EventTypeNormal, "Adopted", "Lookup",
+				"Adopted version %d of subject %s%s with schema ID %d", resp.Version, schema.Spec.Subject, in, resp.ID)
+		case previousID == nil:
+			r.Recorder.Eventf(schema, nil, corev1.EventTypeNormal, "Registered", "Register",
+				"Registered subject %s%s with schema ID %d, version %d", schema.Spec.Subject, in, resp.ID, resp.Version)
+		default:
+			r.Recorder.Eventf(schema, nil, corev1.EventTypeNormal, "NewVersion", "Register",
+				"Registered version %d of subject %s%s with schema ID %d", resp.Version, schema.Spec.Subject, in, resp.ID)
+		}
+		previousID, previousVersion = &resp.ID, &resp.Version
+	}
+
+	if !compatibilityApplied {
+		return
+	}
+	if schema.Status.CompatibilityLevel != schema.Spec.CompatibilityLevel {
+		r.Recorder.Eventf(schema, nil, corev1.EventTypeNormal, "CompatibilityChanged", "SetCompatibility",
+			"Compatibility level of subject %s set to %s", schema.Spec.Subject, schema.Spec.CompatibilityLevel)
+	}
+	schema.Status.CompatibilityLevel = schema.Spec.CompatibilityLevel
+}
+
+// buildClient constructs a Schema Registry HTTP client from the referenced SchemaRegistry CR.
+func (r *SchemaReconciler) buildClient(ctx context.Context, schema *registryv1alpha1.Schema) (schemaclient.Registry, error) {
+	return BuildRegistryClient(ctx, r.Client, schema.Namespace, schema.Spec.RegistryRef)
+}
+
+// deleteFromRegistry deletes the schema subject from every referenced Schema Registry during CR deletion.
+func (r *SchemaReconciler) deleteFromRegistry(ctx context.Context, schema *registryv1alpha1.Schema) error {
+	for _, ref := range SchemaRegistryRefs(schema) {
+		srClient, err := BuildRegistryClient(ctx, r.Client, schema.Namespace, ref)
+		if err != nil {
+			// If the registry itself is gone, we can still proceed with finalizer removal
+			logf.FromContext(ctx).Info("Could not build client during deletion, skipping registry cleanup",
+				"registry", registryRefKey(schema.Namespace, ref), "error", err.Error())
+			continue
+		}
+
+		// A read-only subject rejects the deletion, so its mode goes first
+		if schema.Spec.Mode != "" {
+			if err := srClient.DeleteSubjectMode(ctx, schema.Spec.Subject); err != nil {
+				return err
+			}
+		}
+
+		if err := srClient.DeleteSubject(ctx, schema.Spec.Subject); err != nil {
+			return err
+		}
+
+		r.Recorder.Eventf(schema, nil, corev1.EventTypeNormal, "SubjectDeleted", "Delete",
+			"Deleted subject %s from Schema Registry %s", schema.Spec.Subject, registryRefKey(schema.Namespace, ref))
+	}
+	return nil
 }
 
 // setConditionFailed sets a failed status condition and updates the resource.
+// A Warning event with the same reason is emitted when the condition changes.
 func (r *SchemaReconciler) setConditionFailed(ctx context.Context, schema *registryv1alpha1.Schema, reason, message string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetStatus(codes.Error, message)
+	span.SetAttributes(attribute.String("reason", reason))
+
 	// Re-fetch to avoid conflicts
 	if err := r.Get(ctx, client.ObjectKeyFromObject(schema), schema); err != nil {
 		return client.IgnoreNotFound(err)
 	}
 
+	if readyConditionChanged(schema.Status.Conditions, reason, schema.Generation) {
+		r.Recorder.Eventf(schema, nil, corev1.EventTypeWarning, reason, "Reconcile", "%s", message)
+	}
+
 	meta.SetStatusCondition(&schema.Status.Conditions, metav1.Condition{
 		Type:               "Ready",
 		Status:             metav1.ConditionFalse,
@@ -232,13 +912,16 @@ func (r *SchemaReconciler) findSchemasForRegistry(ctx context.Context, registry
 	}
 	var requests []reconcile.Request
 	for _, schema := range schemaList.Items {
-		if schema.Spec.RegistryRef.Name == registry.GetName() {
-			requests = append(requests, reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Namespace: schema.Namespace,
-					Name:      schema.Name,
-				},
-			})
+		for _, ref := range SchemaRegistryRefs(&schema) {
+			if ref.Name == registry.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: schema.Namespace,
+						Name:      schema.Name,
+					},
+				})
+				break
+			}
 		}
 	}
 	return requests
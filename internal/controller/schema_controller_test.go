@@ -18,16 +18,24 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
 )
 
 var _ = Describe("Schema Controller", func() {
@@ -80,9 +88,11 @@ var _ = Describe("Schema Controller", func() {
 		})
 		It("should add a finalizer and set a failed status condition when registry is not found", func() {
 			By("Reconciling the created resource")
+			recorder := events.NewFakeRecorder(10)
 			controllerReconciler := &SchemaReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
 			}
 
 			// First reconcile: adds finalizer only
@@ -105,6 +115,945 @@ var _ = Describe("Schema Controller", func() {
 			By("Verifying a failed condition is set")
 			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
 			Expect(updated.Status.Conditions).NotTo(BeEmpty())
+
+			By("Verifying the repeated failure emitted a single Warning event")
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(HavePrefix("Warning ClientBuildFailed"))
+		})
+	})
+
+	Context("When the auth Secret of the registry is missing", func() {
+		const resourceName = "test-schema-auth-secret-missing"
+		const registryName = "test-registry-auth-secret-missing"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "http://schema-registry.test.svc.cluster.local:8081",
+					Auth: &registryv1alpha1.AuthConfig{
+						Type: registryv1alpha1.AuthTypeBasic,
+						BasicAuth: &registryv1alpha1.BasicAuthConfig{
+							SecretRef: registryv1alpha1.BasicAuthSecretRef{Name: "missing-credentials"},
+						},
+					},
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     "auth-secret-missing-value",
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					Schema:      `"string"`,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &registryv1alpha1.Schema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Finalizers = nil
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should report AuthSecretMissing once", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			for range 2 {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			resource := &registryv1alpha1.Schema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			ready := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal("AuthSecretMissing"))
+			Expect(<-recorder.Events).To(HavePrefix("Warning AuthSecretMissing"))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("When the schema is registered", func() {
+		const resourceName = "test-schema-events"
+		const registryName = "test-registry-events"
+		const subject = "events-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *httptest.Server
+		var version atomic.Int32
+
+		BeforeEach(func() {
+			version.Store(1)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/subjects/"+subject+"/versions", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"id":%d}`, 100+version.Load())
+			})
+			mux.HandleFunc("/subjects/"+subject+"/versions/latest", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"id":%d,"version":%d}`, 100+version.Load(), version.Load())
+			})
+			mux.HandleFunc("/config/"+subject, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"compatibility":"BACKWARD"}`))
+			})
+			mux.HandleFunc("/subjects/"+subject, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`[1]`))
+			})
+			srv = httptest.NewServer(mux)
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:            subject,
+					SchemaType:         registryv1alpha1.SchemaTypeAvro,
+					Schema:             `"string"`,
+					CompatibilityLevel: "BACKWARD",
+					RegistryRef:        registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should emit lifecycle events once per change", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Registering the first version")
+			reconcileOnce()
+			Expect(<-recorder.Events).To(Equal("Normal Registered Registered subject events-value with schema ID 101, version 1"))
+			Expect(<-recorder.Events).To(HavePrefix("Normal CompatibilityChanged"))
+
+			By("Reconciling again without changes")
+			reconcileOnce()
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Registering a new version")
+			version.Store(2)
+			reconcileOnce()
+			Expect(<-recorder.Events).To(Equal("Normal NewVersion Registered version 2 of subject events-value with schema ID 102"))
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Deleting the subject on finalization")
+			resource := &registryv1alpha1.Schema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(<-recorder.Events).To(HavePrefix("Normal SubjectDeleted"))
+		})
+	})
+
+	Context("When the registry is AWS Glue", func() {
+		const resourceName = "test-schema-glue"
+		const registryName = "test-registry-glue"
+		const secretName = "test-glue-credentials"
+		const subject = "payments-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.GlueServer
+
+		BeforeEach(func() {
+			srv = registrytest.NewGlueServer()
+			srv.RequireSigV4("AKIDGLUE")
+
+			Expect(k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"},
+				Data: map[string][]byte{
+					"accessKeyId":     []byte("AKIDGLUE"),
+					"secretAccessKey": []byte("glue-secret"),
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL:    srv.URL,
+					Flavor: registryv1alpha1.RegistryFlavorGlue,
+					Glue: &registryv1alpha1.GlueConfig{
+						Region:               "eu-west-1",
+						RegistryName:         srv.RegistryName,
+						CredentialsSecretRef: &registryv1alpha1.AWSCredentialsSecretRef{Name: secretName},
+					},
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:            subject,
+					SchemaType:         registryv1alpha1.SchemaTypeAvro,
+					Schema:             `"string"`,
+					CompatibilityLevel: "FULL",
+					RegistryRef:        registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should register and delete the schema with signed requests", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			By("Registering the schema")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(srv.Versions(subject)).To(Equal([]int{1}))
+
+			resource := &registryv1alpha1.Schema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.CompatibilityLevel).To(Equal("FULL"))
+
+			By("Deleting the schema on finalization")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(srv.Versions(subject)).To(BeEmpty())
+		})
+	})
+
+	Context("When the schema references several registries", func() {
+		const resourceName = "test-schema-multi"
+		const subject = "multi-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var primary, dr *registrytest.Server
+
+		BeforeEach(func() {
+			primary = registrytest.NewServer()
+			dr = registrytest.NewServer()
+			// Without sameSchemaId the DR registry would assign ID 1 instead of 2
+			_, _, err := primary.Register("other-value", registrytest.RegisterRequest{Schema: `"int"`})
+			Expect(err).NotTo(HaveOccurred())
+
+			for name, srv := range map[string]*registrytest.Server{"test-registry-primary": primary, "test-registry-dr": dr} {
+				Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+				})).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:    subject,
+					SchemaType: registryv1alpha1.SchemaTypeAvro,
+					Schema:     `"string"`,
+					RegistryRefs: []registryv1alpha1.SchemaRegistryRef{
+						{Name: "test-registry-primary"},
+						{Name: "test-registry-dr"},
+					},
+					SameSchemaID: true,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			primary.Close()
+			dr.Close()
+			for _, name := range []string{"test-registry-primary", "test-registry-dr"} {
+				Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				})).To(Succeed())
+			}
+		})
+
+		It("should register the schema under the same ID in every registry", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			By("Registering the schema")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(primary.Versions(subject)).To(Equal([]int{1}))
+			Expect(dr.Versions(subject)).To(Equal([]int{1}))
+
+			resource := &registryv1alpha1.Schema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(*resource.Status.SchemaID).To(Equal(2))
+			Expect(resource.Status.Registries).To(HaveLen(2))
+			for _, status := range resource.Status.Registries {
+				Expect(status.Ready).To(Equal(metav1.ConditionTrue))
+				Expect(*status.SchemaID).To(Equal(2))
+			}
+
+			By("Deleting the subject from every registry on finalization")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(primary.Versions(subject)).To(BeEmpty())
+			Expect(dr.Versions(subject)).To(BeEmpty())
+		})
+	})
+
+	Context("When the schema is suspended", func() {
+		const resourceName = "test-schema-suspend"
+		const registryName = "test-registry-suspend"
+		const subject = "suspend-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *httptest.Server
+		var requests, deletes atomic.Int32
+
+		BeforeEach(func() {
+			requests.Store(0)
+			deletes.Store(0)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/subjects/"+subject+"/versions", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7}`))
+			})
+			mux.HandleFunc("/subjects/"+subject+"/versions/latest", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7,"version":1}`))
+			})
+			mux.HandleFunc("/subjects/"+subject, func(w http.ResponseWriter, r *http.Request) {
+				deletes.Add(1)
+				_, _ = w.Write([]byte(`[1]`))
+			})
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				mux.ServeHTTP(w, r)
+			}))
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     subject,
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					Schema:      `"string"`,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should not contact the registry until resumed", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			setSuspend := func(suspend bool) {
+				resource := &registryv1alpha1.Schema{}
+				Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				resource.Spec.Suspend = suspend
+				Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			}
+
+			By("Registering the schema")
+			reconcileOnce()
+			Expect(<-recorder.Events).To(HavePrefix("Normal Registered"))
+
+			By("Suspending the schema")
+			setSuspend(true)
+			requests.Store(0)
+			reconcileOnce()
+			Expect(requests.Load()).To(BeZero())
+			Expect(<-recorder.Events).To(HavePrefix("Normal Suspended"))
+			resource := &registryv1alpha1.Schema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			suspended := meta.FindStatusCondition(resource.Status.Conditions, "Suspended")
+			Expect(suspended).NotTo(BeNil())
+			Expect(suspended.Status).To(Equal(metav1.ConditionTrue))
+
+			By("Keeping the finalizer when deleted while suspended")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(requests.Load()).To(BeZero())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(schemaFinalizer))
+
+			By("Deleting the subject once resumed")
+			setSuspend(false)
+			reconcileOnce()
+			Expect(<-recorder.Events).To(HavePrefix("Normal Resumed"))
+			Expect(<-recorder.Events).To(HavePrefix("Normal SubjectDeleted"))
+			Expect(deletes.Load()).To(Equal(int32(1)))
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When the schema is observe-only", func() {
+		const resourceName = "test-schema-observe"
+		const registryName = "test-registry-observe"
+		const subject = "observe-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *httptest.Server
+		var found atomic.Bool
+		var writes atomic.Int32
+
+		BeforeEach(func() {
+			found.Store(false)
+			writes.Store(0)
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/subjects/"+subject {
+					writes.Add(1)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				if !found.Load() {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"error_code":40403,"message":"Schema not found"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"subject":"observe-value","id":12,"version":4,"schema":"\"string\""}`))
+			}))
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:            subject,
+					SchemaType:         registryv1alpha1.SchemaTypeAvro,
+					Schema:             `"string"`,
+					CompatibilityLevel: "BACKWARD",
+					ObserveOnly:        true,
+					RegistryRef:        registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should adopt the existing version without writing to the registry", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.Schema{}
+
+			By("Reporting a content mismatch")
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			ready := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal("ContentMismatch"))
+			Expect(resource.Status.SchemaID).To(BeNil())
+
+			By("Populating the status once the schema is found")
+			found.Store(true)
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.SchemaID).To(HaveValue(Equal(12)))
+			Expect(resource.Status.Version).To(HaveValue(Equal(4)))
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+
+			By("Keeping the subject on deletion")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(writes.Load()).To(BeZero())
+		})
+	})
+
+	Context("When the subject is read-only", func() {
+		const resourceName = "test-schema-readonly"
+		const registryName = "test-registry-readonly"
+		const subject = "readonly-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.Server
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+			Expect(srv.SetMode("", "READONLY")).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     subject,
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					Schema:      `"string"`,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should report RegistryReadOnly until spec.mode unlocks the subject", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.Schema{}
+
+			By("Refusing the registration in the read-only registry")
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			ready := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal("RegistryReadOnly"))
+			Expect(srv.Versions(subject)).To(BeEmpty())
+
+			By("Registering and locking the subject with spec.mode")
+			Expect(srv.SetMode("", "READWRITE")).To(Succeed())
+			resource.Spec.Mode = registryv1alpha1.RegistryModeReadOnly
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(Equal([]int{1}))
+			Expect(srv.Mode(subject)).To(Equal("READONLY"))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.Mode).To(Equal("READONLY"))
+
+			By("Keeping the registered version ready while locked")
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+
+			By("Unlocking the subject to delete it")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(BeEmpty())
+		})
+	})
+
+	Context("When spec.mode is set and the registry cannot delete subject modes", func() {
+		const resourceName = "test-schema-mode-unsupported"
+		const registryName = "test-registry-mode-unsupported"
+		const subject = "mode-unsupported-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.Server
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     subject,
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					Schema:      `"string"`,
+					Mode:        registryv1alpha1.RegistryModeReadWrite,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should still delete the subject and remove the finalizer", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.Schema{}
+
+			By("Registering the schema")
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(Equal([]int{1}))
+
+			By("Deleting the Schema while the registry answers mode deletions with 405")
+			srv.AddFault(registrytest.Fault{Method: http.MethodDelete, Path: "/mode/", StatusCode: http.StatusMethodNotAllowed})
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(BeEmpty())
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When the schema ID is explicit", func() {
+		const resourceName = "test-schema-explicit-id"
+		const registryName = "test-registry-explicit-id"
+		const subject = "explicit-id-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var (
+			srv     *registrytest.Server
+			takenID int
+		)
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+			var err error
+			takenID, _, err = srv.Register("other-value", registrytest.RegisterRequest{Schema: `"int"`})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     subject,
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					Schema:      `"string"`,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+					SchemaID:    takenID,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should report IDConflict for a used ID and import under a free one", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.Schema{}
+
+			By("Refusing an ID that holds a different schema")
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			ready := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal("IDConflict"))
+			Expect(srv.Versions(subject)).To(BeEmpty())
+
+			By("Importing the schema under a free ID and version")
+			resource.Spec.SchemaID = 100
+			resource.Spec.Version = 3
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(Equal([]int{3}))
+			// The subject returns to the global mode after the import
+			Expect(srv.Mode(subject)).To(BeEmpty())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(*resource.Status.SchemaID).To(Equal(100))
+			Expect(*resource.Status.Version).To(Equal(3))
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+		})
+	})
+
+	Context("When the schema ID is explicit and spec.mode is set", func() {
+		const resourceName = "test-schema-explicit-id-mode"
+		const registryName = "test-registry-explicit-id-mode"
+		const subject = "explicit-id-mode-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.Server
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+			Expect(srv.SetMode("", "READONLY")).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     subject,
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					Schema:      `"string"`,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+					SchemaID:    100,
+					Mode:        registryv1alpha1.RegistryModeReadWrite,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should restore spec.mode after the import", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.Schema{}
+
+			By("Importing the schema into the unlocked subject")
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(Equal([]int{1}))
+			Expect(srv.Mode(subject)).To(Equal("READWRITE"))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(*resource.Status.SchemaID).To(Equal(100))
+			Expect(resource.Status.Mode).To(Equal("READWRITE"))
+
+			By("Locking the subject after the import with a read-only spec.mode")
+			resource.Spec.Schema = `"bytes"`
+			resource.Spec.SchemaID = 101
+			resource.Spec.Mode = registryv1alpha1.RegistryModeReadOnly
+			Expect(srv.SetMode("", "READWRITE")).To(Succeed())
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(Equal([]int{1, 2}))
+			Expect(srv.Mode(subject)).To(Equal("READONLY"))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(*resource.Status.SchemaID).To(Equal(101))
+			Expect(resource.Status.Mode).To(Equal("READONLY"))
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(BeEmpty())
+		})
+	})
+
+	Context("When the schema lists its versions", func() {
+		const resourceName = "test-schema-versions"
+		const registryName = "test-registry-versions"
+		const subject = "versions-value"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.Server
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+			// The first version is already in the registry
+			_, _, err := srv.Register(subject, registrytest.RegisterRequest{Schema: `"int"`})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.Schema{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaSpec{
+					Subject:     subject,
+					SchemaType:  registryv1alpha1.SchemaTypeAvro,
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+					Versions: []registryv1alpha1.SchemaVersion{
+						{Schema: `"int"`, Version: 1},
+						{Schema: `"long"`},
+					},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should register the missing versions in order and verify pinned versions", func() {
+			controllerReconciler := &SchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.Schema{}
+
+			By("Registering the versions missing in the subject")
+			reconcileOnce()
+			reconcileOnce()
+			Expect(srv.Versions(subject)).To(Equal([]int{1, 2}))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.Versions).To(Equal([]registryv1alpha1.SchemaVersionStatus{
+				{Version: 1, SchemaID: 1},
+				{Version: 2, SchemaID: 2},
+			}))
+			Expect(*resource.Status.Version).To(Equal(2))
+
+			By("Reporting a version number that differs from the pinned one")
+			resource.Spec.Versions = append(resource.Spec.Versions, registryv1alpha1.SchemaVersion{Schema: `"double"`, Version: 5})
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			ready := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal("VersionMismatch"))
+			Expect(ready.Message).To(HavePrefix("spec.versions[2]"))
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
 		})
 	})
 })
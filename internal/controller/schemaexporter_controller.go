@@ -0,0 +1,432 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+)
+
+const schemaExporterFinalizer = "registry.strimzi.io/schemaexporter-finalizer"
+
+// SchemaExporterReconciler reconciles a SchemaExporter object
+type SchemaExporterReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaexporters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaexporters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaexporters/finalizers,verbs=update
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile creates or updates the exporter in the registry referenced by spec.registryRef,
+// pauses or resumes it according to spec.paused and reports its state and offset in the
+// status. It re-queues every minute to follow the exporter state. A finalizer deletes the
+// exporter before the CR is deleted; the exported subjects stay in the destination.
+func (r *SchemaExporterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracer.Start(ctx, "SchemaExporter.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.schemaexporter.name", req.Name),
+	))
+	defer span.End()
+
+	log := logf.FromContext(ctx)
+
+	var exporter registryv1alpha1.SchemaExporter
+	if err := r.Get(ctx, req.NamespacedName, &exporter); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	name := exporterName(&exporter)
+	span.SetAttributes(
+		attribute.String("schemaexporter.exporter", name),
+		registryRefAttribute(exporter.Namespace, exporter.Spec.RegistryRef),
+	)
+
+	// --- Deletion path ---
+	if !exporter.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(&exporter, schemaExporterFinalizer) {
+			log.Info("Deleting exporter from registry", "exporter", name)
+			if err := r.deleteExporter(ctx, &exporter); err != nil {
+				log.Error(err, "Failed to delete exporter from registry")
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(&exporter, schemaExporterFinalizer)
+			if err := r.Update(ctx, &exporter); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// --- Add finalizer if missing ---
+	if !controllerutil.ContainsFinalizer(&exporter, schemaExporterFinalizer) {
+		controllerutil.AddFinalizer(&exporter, schemaExporterFinalizer)
+		if err := r.Update(ctx, &exporter); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Re-fetch after update
+		if err := r.Get(ctx, req.NamespacedName, &exporter); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
+	// --- Build Schema Registry client ---
+	manager, err := r.exporterManager(ctx, &exporter)
+	if err != nil {
+		log.Error(err, "Failed to build Schema Registry client")
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "ClientBuildFailed", err.Error())
+	}
+	if manager == nil {
+		return ctrl.Result{}, r.setConditionFailed(ctx, &exporter, "Unsupported",
+			"Schema exporters are only supported by registries with the Confluent API")
+	}
+
+	destinationConfig, err := exporterDestinationConfig(ctx, r.Client, exporter.Namespace, exporter.Spec.DestinationRef, exporter.Spec.Config)
+	if err != nil {
+		log.Error(err, "Failed to build exporter destination config")
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "DestinationConfigFailed", err.Error())
+	}
+	desired := desiredExporter(&exporter, destinationConfig)
+
+	// --- Create or update the exporter ---
+	current, err := manager.GetExporter(ctx, name)
+	if err != nil {
+		log.Error(err, "Failed to get exporter", "exporter", name)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "ExporterUnavailable", err.Error())
+	}
+	switch {
+	case current == nil:
+		if err := manager.CreateExporter(ctx, desired); err != nil {
+			log.Error(err, "Failed to create exporter", "exporter", name)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "CreateFailed", err.Error())
+		}
+		log.Info("Exporter created", "exporter", name)
+		r.Recorder.Eventf(&exporter, nil, corev1.EventTypeNormal, "Created", "Create",
+			"Created exporter %s", name)
+	case !exporterMatches(current, &desired):
+		if err := manager.UpdateExporter(ctx, desired); err != nil {
+			log.Error(err, "Failed to update exporter", "exporter", name)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "UpdateFailed", err.Error())
+		}
+		log.Info("Exporter updated", "exporter", name)
+		r.Recorder.Eventf(&exporter, nil, corev1.EventTypeNormal, "Updated", "Update",
+			"Updated exporter %s", name)
+	}
+
+	// --- Pause or resume ---
+	status, err := manager.GetExporterStatus(ctx, name)
+	if err != nil {
+		log.Error(err, "Failed to get exporter status", "exporter", name)
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "ExporterUnavailable", err.Error())
+	}
+	paused := status.State == schemaclient.ExporterStatePaused
+	if exporter.Spec.Paused != paused {
+		action, reason, verb := manager.ResumeExporter, "Resumed", "Resume"
+		if exporter.Spec.Paused {
+			action, reason, verb = manager.PauseExporter, "Paused", "Pause"
+		}
+		if err := action(ctx, name); err != nil {
+			log.Error(err, "Failed to change exporter state", "exporter", name, "paused", exporter.Spec.Paused)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, verb+"Failed", err.Error())
+		}
+		r.Recorder.Eventf(&exporter, nil, corev1.EventTypeNormal, reason, verb,
+			"%s exporter %s", reason, name)
+		if status, err = manager.GetExporterStatus(ctx, name); err != nil {
+			log.Error(err, "Failed to get exporter status", "exporter", name)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &exporter, "ExporterUnavailable", err.Error())
+		}
+	}
+
+	// Re-fetch before status update to avoid conflicts
+	if err := r.Get(ctx, req.NamespacedName, &exporter); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	exporter.Status.ExporterName = name
+	exporter.Status.State = status.State
+	exporter.Status.Offset = &status.Offset
+	exporter.Status.Trace = status.Trace
+	exporter.Status.StateChangeTime = nil
+	if status.Timestamp > 0 {
+		changed := metav1.NewTime(time.UnixMilli(status.Timestamp))
+		exporter.Status.StateChangeTime = &changed
+	}
+	exporter.Status.ObservedGeneration = exporter.Generation
+
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Running",
+		Message:            fmt.Sprintf("Exporter %s is %s", name, strings.ToLower(status.State)),
+		ObservedGeneration: exporter.Generation,
+	}
+	switch status.State {
+	case schemaclient.ExporterStatePaused:
+		condition.Reason = "Paused"
+	case schemaclient.ExporterStateError:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ExporterError"
+		condition.Message = fmt.Sprintf("Exporter %s failed: %s", name, status.Trace)
+		span.SetStatus(codes.Error, condition.Message)
+		if readyConditionChanged(exporter.Status.Conditions, condition.Reason, exporter.Generation) {
+			r.Recorder.Eventf(&exporter, nil, corev1.EventTypeWarning, condition.Reason, "Reconcile", "%s", condition.Message)
+		}
+	}
+	meta.SetStatusCondition(&exporter.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, &exporter); err != nil {
+		log.Error(err, "Failed to update SchemaExporter status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: time.Minute}, nil
+}
+
+// exporterManager builds the client of the registry running the exporter. It returns nil
+// without error when the registry API has no exporters.
+func (r *SchemaExporterReconciler) exporterManager(ctx context.Context, exporter *registryv1alpha1.SchemaExporter) (schemaclient.ExporterManager, error) {
+	srClient, err := BuildRegistryClient(ctx, r.Client, exporter.Namespace, exporter.Spec.RegistryRef)
+	if err != nil {
+		return nil, err
+	}
+	manager, _ := srClient.(schemaclient.ExporterManager)
+	return manager, nil
+}
+
+// deleteExporter deletes the exporter from the registry during CR deletion.
+func (r *SchemaExporterReconciler) deleteExporter(ctx context.Context, exporter *registryv1alpha1.SchemaExporter) error {
+	manager, err := r.exporterManager(ctx, exporter)
+	if err != nil {
+		// If the registry itself is gone, we can still proceed with finalizer removal
+		logf.FromContext(ctx).Info("Could not build client during deletion, skipping registry cleanup", "error", err.Error())
+		return nil
+	}
+	if manager == nil {
+		// The exporter was never created in a registry without exporters
+		return nil
+	}
+	name := exporterName(exporter)
+	if err := manager.DeleteExporter(ctx, name); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(exporter, nil, corev1.EventTypeNormal, "Deleted", "Delete",
+		"Deleted exporter %s", name)
+	return nil
+}
+
+// setConditionFailed sets a failed status condition and updates the resource.
+// A Warning event with the same reason is emitted when the condition changes.
+func (r *SchemaExporterReconciler) setConditionFailed(ctx context.Context, exporter *registryv1alpha1.SchemaExporter, reason, message string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetStatus(codes.Error, message)
+	span.SetAttributes(attribute.String("reason", reason))
+
+	// Re-fetch to avoid conflicts
+	if err := r.Get(ctx, client.ObjectKeyFromObject(exporter), exporter); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if readyConditionChanged(exporter.Status.Conditions, reason, exporter.Generation) {
+		r.Recorder.Eventf(exporter, nil, corev1.EventTypeWarning, reason, "Reconcile", "%s", message)
+	}
+
+	meta.SetStatusCondition(&exporter.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: exporter.Generation,
+	})
+
+	return r.Status().Update(ctx, exporter)
+}
+
+// exporterName returns the name of the exporter in the registry: spec.exporterName, or metadata.name.
+func exporterName(exporter *registryv1alpha1.SchemaExporter) string {
+	if exporter.Spec.ExporterName != "" {
+		return exporter.Spec.ExporterName
+	}
+	return exporter.Name
+}
+
+// desiredExporter builds the exporter for the spec, with the destination config
+// overridden by spec.config.
+func desiredExporter(exporter *registryv1alpha1.SchemaExporter, destinationConfig map[string]string) schemaclient.Exporter {
+	config := maps.Clone(destinationConfig)
+	maps.Copy(config, exporter.Spec.Config)
+
+	contextType := string(exporter.Spec.ContextType)
+	if contextType == "" {
+		contextType = schemaclient.ExporterContextAuto
+	}
+	subjects := exporter.Spec.Subjects
+	if len(subjects) == 0 {
+		subjects = []string{"*"}
+	}
+	return schemaclient.Exporter{
+		Name:                exporterName(exporter),
+		ContextType:         contextType,
+		Context:             exporter.Spec.Context,
+		Subjects:            subjects,
+		SubjectRenameFormat: exporter.Spec.SubjectRenameFormat,
+		Config:              config,
+	}
+}
+
+// exporterMatches reports whether the exporter in the registry has the desired settings.
+// The context is only compared for the CUSTOM type, the registry fills it in for AUTO.
+func exporterMatches(current, desired *schemaclient.Exporter) bool {
+	if desired.ContextType == schemaclient.ExporterContextCustom && current.Context != desired.Context {
+		return false
+	}
+	return current.ContextType == desired.ContextType &&
+		slices.Equal(current.Subjects, desired.Subjects) &&
+		current.SubjectRenameFormat == desired.SubjectRenameFormat &&
+		maps.Equal(current.Config, desired.Config)
+}
+
+// exporterDestinationConfig builds the exporter properties connecting to the SchemaRegistry
+// referenced by ref: its URLs and its BASIC or BEARER credentials. The exporter runs in the
+// source registry, so other authentication types cannot be passed on. Neither can TLS
+// settings, unless overrides (spec.config) carries schema.registry.ssl.* properties.
+func exporterDestinationConfig(ctx context.Context, k8sClient client.Client, namespace string,
+	ref registryv1alpha1.SchemaRegistryRef, overrides map[string]string) (map[string]string, error) {
+	registryNamespace := ref.Namespace
+	if registryNamespace == "" {
+		registryNamespace = namespace
+	}
+
+	var destination registryv1alpha1.SchemaRegistry
+	if err := k8sClient.Get(ctx, client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: registryNamespace,
+	}, &destination); err != nil {
+		return nil, fmt.Errorf("failed to get SchemaRegistry %q: %w", ref.Name, err)
+	}
+
+	urls := registryURLs(&destination)
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("SchemaRegistry %q has no URL", ref.Name)
+	}
+	config := map[string]string{
+		"schema.registry.url": strings.Join(urls, ","),
+	}
+
+	// Without them the exporter would only fail the TLS handshake at runtime
+	customTLS := destination.Spec.InsecureSkipVerify || destination.Spec.TLS != nil ||
+		(destination.Spec.Auth != nil && destination.Spec.Auth.KafkaUserRef != nil && destination.Spec.Auth.KafkaUserRef.ClusterCASecretRef != "")
+	if customTLS && !hasSSLConfig(overrides) {
+		return nil, fmt.Errorf("TLS settings of SchemaRegistry %q are not supported by exporters, "+
+			"set the schema.registry.ssl.* properties in spec.config instead", ref.Name)
+	}
+
+	authConfig, err := loadAuthConfig(ctx, k8sClient, &destination)
+	if err != nil {
+		return nil, err
+	}
+	switch authConfig.Type {
+	case "NONE":
+	case string(registryv1alpha1.AuthTypeBasic):
+		config["basic.auth.credentials.source"] = "USER_INFO"
+		config["basic.auth.user.info"] = authConfig.Username + ":" + authConfig.Password
+	case string(registryv1alpha1.AuthTypeBearer):
+		config["bearer.auth.credentials.source"] = "STATIC_TOKEN"
+		config["bearer.auth.token"] = authConfig.BearerToken
+	default:
+		return nil, fmt.Errorf("authentication type %s of SchemaRegistry %q is not supported by exporters", authConfig.Type, ref.Name)
+	}
+	return config, nil
+}
+
+// hasSSLConfig reports whether config sets any schema.registry.ssl.* exporter property.
+func hasSSLConfig(config map[string]string) bool {
+	for key := range config {
+		if strings.HasPrefix(key, "schema.registry.ssl.") {
+			return true
+		}
+	}
+	return false
+}
+
+// findExportersForRegistry maps a SchemaRegistry change to SchemaExporter reconcile requests.
+func (r *SchemaExporterReconciler) findExportersForRegistry(ctx context.Context, registry client.Object) []reconcile.Request {
+	exporterList := &registryv1alpha1.SchemaExporterList{}
+	if err := r.List(ctx, exporterList); err != nil {
+		return nil
+	}
+	key := registry.GetNamespace() + "/" + registry.GetName()
+	var requests []reconcile.Request
+	for _, exporter := range exporterList.Items {
+		if registryRefKey(exporter.Namespace, exporter.Spec.RegistryRef) == key ||
+			registryRefKey(exporter.Namespace, exporter.Spec.DestinationRef) == key {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: exporter.Namespace,
+					Name:      exporter.Name,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SchemaExporterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// The status is updated on every reconcile, so only spec, annotation and deletion changes start another one
+		For(&registryv1alpha1.SchemaExporter{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}),
+		)).
+		// Health checks update the registry status every few minutes, only spec changes are relevant
+		Watches(
+			&registryv1alpha1.SchemaRegistry{},
+			handler.EnqueueRequestsFromMapFunc(r.findExportersForRegistry),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Named("schemaexporter").
+		Complete(r)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
+)
+
+var _ = Describe("SchemaExporter Controller", func() {
+	Context("When exporting to another registry", func() {
+		const resourceName = "test-exporter"
+		const sourceName = "test-exporter-source"
+		const destinationName = "test-exporter-destination"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var source, destination *registrytest.Server
+
+		BeforeEach(func() {
+			source = registrytest.NewServer()
+			destination = registrytest.NewServer()
+
+			for name, srv := range map[string]*registrytest.Server{sourceName: source, destinationName: destination} {
+				Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+				})).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaExporter{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaExporterSpec{
+					RegistryRef:    registryv1alpha1.SchemaRegistryRef{Name: sourceName},
+					DestinationRef: registryv1alpha1.SchemaRegistryRef{Name: destinationName},
+					ContextType:    registryv1alpha1.ExporterContextCustom,
+					Context:        "prod",
+					Subjects:       []string{"orders-value"},
+					Config:         map[string]string{"schema.registry.request.timeout.ms": "5000"},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			source.Close()
+			destination.Close()
+			for _, name := range []string{sourceName, destinationName} {
+				Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				})).To(Succeed())
+			}
+		})
+
+		It("should manage the exporter from creation to deletion", func() {
+			controllerReconciler := &SchemaExporterReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			By("Creating the exporter with the destination config")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			exporter, status, found := source.GetExporter(resourceName)
+			Expect(found).To(BeTrue())
+			Expect(exporter.ContextType).To(Equal("CUSTOM"))
+			Expect(exporter.Context).To(Equal("prod"))
+			Expect(exporter.Subjects).To(Equal([]string{"orders-value"}))
+			Expect(exporter.Config).To(Equal(map[string]string{
+				"schema.registry.url":                destination.URL,
+				"schema.registry.request.timeout.ms": "5000",
+			}))
+			Expect(status.State).To(Equal("RUNNING"))
+
+			resource := &registryv1alpha1.SchemaExporter{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.State).To(Equal("RUNNING"))
+			Expect(resource.Status.Offset).NotTo(BeNil())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+
+			By("Pausing the exporter")
+			resource.Spec.Paused = true
+			resource.Spec.Subjects = []string{"*"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			exporter, status, _ = source.GetExporter(resourceName)
+			Expect(exporter.Subjects).To(Equal([]string{"*"}))
+			Expect(status.State).To(Equal("PAUSED"))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.FindStatusCondition(resource.Status.Conditions, "Ready").Reason).To(Equal("Paused"))
+
+			By("Reporting an exporter error")
+			resource.Spec.Paused = false
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			source.SetExporterState(resourceName, "ERROR", 42, "destination unreachable")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(*resource.Status.Offset).To(Equal(int64(42)))
+			Expect(resource.Status.Trace).To(Equal("destination unreachable"))
+			cond := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("ExporterError"))
+
+			By("Deleting the exporter with the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, found = source.GetExporter(resourceName)
+			Expect(found).To(BeFalse())
+			err = k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject a TLS destination until spec.config sets the truststore", func() {
+			controllerReconciler := &SchemaExporterReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			registry := &registryv1alpha1.SchemaRegistry{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: destinationName, Namespace: "default"}, registry)).To(Succeed())
+			registry.Spec.TLS = &registryv1alpha1.TLSConfig{
+				CA: &registryv1alpha1.CABundleSource{SecretRef: &registryv1alpha1.CASecretRef{Name: "destination-ca"}},
+			}
+			Expect(k8sClient.Update(ctx, registry)).To(Succeed())
+
+			By("Refusing to create an exporter that would fail the TLS handshake")
+			reconcileOnce()
+			_, _, found := source.GetExporter(resourceName)
+			Expect(found).To(BeFalse())
+			resource := &registryv1alpha1.SchemaExporter{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			cond := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("DestinationConfigFailed"))
+			Expect(cond.Message).To(ContainSubstring("schema.registry.ssl."))
+
+			By("Creating the exporter with the truststore from spec.config")
+			resource.Spec.Config["schema.registry.ssl.truststore.location"] = "/etc/exporter/truststore.jks"
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			_, _, found = source.GetExporter(resourceName)
+			Expect(found).To(BeTrue())
+
+			By("Deleting the exporter with the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
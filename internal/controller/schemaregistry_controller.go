@@ -18,13 +18,20 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -38,61 +45,171 @@ import (
 // SchemaRegistryReconciler reconciles a SchemaRegistry object
 type SchemaRegistryReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries/finalizers,verbs=update
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
-// Reconcile performs a health check against the Schema Registry endpoint and
-// updates the SchemaRegistry status with the current connectivity state.
-// It re-queues every 5 minutes for periodic health monitoring.
+// Reconcile performs a health check against the Schema Registry endpoints and
+// updates the SchemaRegistry status with the current connectivity state and
+// registry configuration, applying spec.mode when the registry is in another
+// mode. It re-queues after spec.healthCheckInterval (5 minutes
+// by default) for periodic health monitoring, unless spec.suspend is set.
 func (r *SchemaRegistryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracer.Start(ctx, "SchemaRegistry.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.schemaregistry.name", req.Name),
+	))
+	defer span.End()
+
 	log := logf.FromContext(ctx)
 
 	var schemaRegistry registryv1alpha1.SchemaRegistry
 	if err := r.Get(ctx, req.NamespacedName, &schemaRegistry); err != nil {
+		if apierrors.IsNotFound(err) {
+			forgetRoundRobinCounter(req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	span.SetAttributes(attribute.StringSlice("schemaregistry.urls", registryURLs(&schemaRegistry)))
+
+	// A suspended registry is not health checked; the last observed status is kept
+	wasSuspended := meta.IsStatusConditionTrue(schemaRegistry.Status.Conditions, "Suspended")
+	if updateSuspendedCondition(&schemaRegistry.Status.Conditions, schemaRegistry.Spec.Suspend, schemaRegistry.Generation) {
+		if err := r.Status().Update(ctx, &schemaRegistry); err != nil {
+			log.Error(err, "Failed to update SchemaRegistry status")
+			return ctrl.Result{}, err
+		}
+		switch {
+		case schemaRegistry.Spec.Suspend && !wasSuspended:
+			r.Recorder.Eventf(&schemaRegistry, nil, corev1.EventTypeNormal, "Suspended", "Suspend",
+				"Health checks of Schema Registry are suspended")
+		case !schemaRegistry.Spec.Suspend && wasSuspended:
+			r.Recorder.Eventf(&schemaRegistry, nil, corev1.EventTypeNormal, "Resumed", "Resume",
+				"Health checks of Schema Registry are resumed")
+		}
+	}
+	if schemaRegistry.Spec.Suspend {
+		log.Info("SchemaRegistry reconciliation is suspended")
+		return ctrl.Result{}, nil
+	}
 
 	// Build the Schema Registry HTTP client from spec + secrets
 	authConfig, err := loadAuthConfig(ctx, r.Client, &schemaRegistry)
 	if err != nil {
 		log.Error(err, "Failed to load auth config")
-		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, "AuthLoadFailed", err.Error())
+		reason := "AuthLoadFailed"
+		if apierrors.IsNotFound(err) {
+			reason = "AuthSecretMissing"
+		}
+		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, reason, err.Error())
 	}
 
-	timeout := time.Duration(schemaRegistry.Spec.Timeout) * time.Second
-	if timeout == 0 {
-		timeout = 30 * time.Second
+	tlsConfig, err := loadTLSConfig(ctx, r.Client, &schemaRegistry)
+	if err != nil {
+		log.Error(err, "Failed to load TLS config")
+		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, "TLSLoadFailed", err.Error())
 	}
 
-	srClient, err := schemaclient.NewClient(
-		schemaRegistry.Spec.URL,
-		authConfig,
-		timeout,
-		schemaRegistry.Spec.InsecureSkipVerify,
-	)
+	proxyConfig, err := loadProxyConfig(ctx, r.Client, &schemaRegistry)
+	if err != nil {
+		log.Error(err, "Failed to load proxy config")
+		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, "ProxyLoadFailed", err.Error())
+	}
+
+	headers, err := loadHeaders(ctx, r.Client, &schemaRegistry)
+	if err != nil {
+		log.Error(err, "Failed to load headers")
+		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, "HeadersLoadFailed", err.Error())
+	}
+
+	glueOptions, err := loadGlueOptions(ctx, r.Client, &schemaRegistry)
+	if err != nil {
+		log.Error(err, "Failed to load AWS credentials")
+		reason := "AWSCredentialsLoadFailed"
+		if apierrors.IsNotFound(err) {
+			reason = "AuthSecretMissing"
+		}
+		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, reason, err.Error())
+	}
+
+	opts := registryOptions(&schemaRegistry, tlsConfig, proxyConfig, headers, glueOptions)
+	srClient, err := schemaclient.New(string(schemaRegistry.Spec.Flavor), registryURLs(&schemaRegistry), authConfig, opts)
 	if err != nil {
 		log.Error(err, "Failed to create Schema Registry client")
 		return ctrl.Result{}, r.setConditionFailed(ctx, &schemaRegistry, "ClientCreateFailed", err.Error())
 	}
 
-	// Health check
-	healthErr := srClient.HealthCheck(ctx)
+	// Health check every endpoint individually
+	results := srClient.CheckEndpoints(ctx)
+
+	var info registryInfo
+	reachable := false
+	for _, result := range results {
+		if result.Err == nil {
+			info = collectRegistryInfo(ctx, srClient)
+			reachable = true
+			break
+		}
+	}
+
+	// Apply spec.mode when the registry reports another one
+	var modeErr error
+	modeChanged := false
+	if mode := string(schemaRegistry.Spec.Mode); mode != "" && reachable && info.mode != mode {
+		if modeErr = srClient.SetMode(ctx, mode); modeErr != nil {
+			log.Error(modeErr, "Failed to set global mode", "mode", mode)
+		} else {
+			info.mode = mode
+			modeChanged = true
+		}
+	}
 
 	// Re-fetch before status update to avoid conflicts
 	if err := r.Get(ctx, req.NamespacedName, &schemaRegistry); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	previousConnectionStatus := schemaRegistry.Status.ConnectionStatus
 	schemaRegistry.Status.ObservedGeneration = schemaRegistry.Generation
 	now := metav1.Now()
 	schemaRegistry.Status.LastChecked = &now
 
-	if healthErr != nil {
+	endpoints := make([]registryv1alpha1.EndpointStatus, 0, len(results))
+	var healthErrs []error
+	for _, result := range results {
+		endpoint := registryv1alpha1.EndpointStatus{
+			URL:                 result.URL,
+			Reachable:           result.Err == nil,
+			LatencyMilliseconds: result.Latency.Milliseconds(),
+		}
+		if result.Err != nil {
+			endpoint.Message = result.Err.Error()
+			healthErrs = append(healthErrs, result.Err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	schemaRegistry.Status.Endpoints = endpoints
+	// Keep the last detected flavor when detection fails
+	if info.flavor != "" {
+		schemaRegistry.Status.DetectedFlavor = info.flavor
+	}
+	schemaRegistry.Status.ServerVersion = info.serverVersion
+	schemaRegistry.Status.CompatibilityLevel = info.compatibilityLevel
+	schemaRegistry.Status.Mode = info.mode
+	schemaRegistry.Status.SchemaTypes = info.schemaTypes
+	schemaRegistry.Status.SubjectCount = info.subjectCount
+	schemaRegistry.Status.LatencyMilliseconds = info.latencyMilliseconds
+
+	switch {
+	case len(healthErrs) == len(results):
+		healthErr := errors.Join(healthErrs...)
 		log.Error(healthErr, "Schema Registry health check failed")
 		meta.SetStatusCondition(&schemaRegistry.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
@@ -102,7 +219,20 @@ func (r *SchemaRegistryReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			ObservedGeneration: schemaRegistry.Generation,
 		})
 		schemaRegistry.Status.ConnectionStatus = "Unreachable"
-	} else {
+	case len(healthErrs) > 0:
+		// Requests fail over to the reachable endpoints, so the registry stays usable
+		log.Info("Some Schema Registry endpoints are unreachable",
+			"unreachable", len(healthErrs), "endpoints", len(results))
+		meta.SetStatusCondition(&schemaRegistry.Status.Conditions, metav1.Condition{
+			Type:   "Ready",
+			Status: metav1.ConditionTrue,
+			Reason: "Degraded",
+			Message: fmt.Sprintf("Connected to %d of %d Schema Registry endpoints",
+				len(results)-len(healthErrs), len(results)),
+			ObservedGeneration: schemaRegistry.Generation,
+		})
+		schemaRegistry.Status.ConnectionStatus = "Degraded"
+	default:
 		log.Info("Schema Registry health check succeeded")
 		meta.SetStatusCondition(&schemaRegistry.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
@@ -114,22 +244,135 @@ func (r *SchemaRegistryReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		schemaRegistry.Status.ConnectionStatus = "Connected"
 	}
 
+	switch {
+	case schemaRegistry.Spec.Mode == "":
+		meta.RemoveStatusCondition(&schemaRegistry.Status.Conditions, "ModeApplied")
+	case modeErr != nil:
+		if !meta.IsStatusConditionFalse(schemaRegistry.Status.Conditions, "ModeApplied") {
+			r.Recorder.Eventf(&schemaRegistry, nil, corev1.EventTypeWarning, "ModeChangeFailed", "SetMode",
+				"Failed to set global mode to %s: %s", schemaRegistry.Spec.Mode, modeErr.Error())
+		}
+		meta.SetStatusCondition(&schemaRegistry.Status.Conditions, metav1.Condition{
+			Type:               "ModeApplied",
+			Status:             metav1.ConditionFalse,
+			Reason:             "ModeChangeFailed",
+			Message:            modeErr.Error(),
+			ObservedGeneration: schemaRegistry.Generation,
+		})
+	case info.mode == string(schemaRegistry.Spec.Mode):
+		if modeChanged {
+			r.Recorder.Eventf(&schemaRegistry, nil, corev1.EventTypeNormal, "ModeChanged", "SetMode",
+				"Global mode of Schema Registry set to %s", schemaRegistry.Spec.Mode)
+		}
+		meta.SetStatusCondition(&schemaRegistry.Status.Conditions, metav1.Condition{
+			Type:               "ModeApplied",
+			Status:             metav1.ConditionTrue,
+			Reason:             "ModeApplied",
+			Message:            fmt.Sprintf("Global mode is %s", schemaRegistry.Spec.Mode),
+			ObservedGeneration: schemaRegistry.Generation,
+		})
+	}
+
+	// Only transitions are reported, so the periodic health check does not repeat events
+	switch {
+	case schemaRegistry.Status.ConnectionStatus == "Unreachable" && previousConnectionStatus != "Unreachable":
+		r.Recorder.Eventf(&schemaRegistry, nil, corev1.EventTypeWarning, "Unreachable", "HealthCheck",
+			"Schema Registry is unreachable: %s", meta.FindStatusCondition(schemaRegistry.Status.Conditions, "Ready").Message)
+	case schemaRegistry.Status.ConnectionStatus != "Unreachable" && previousConnectionStatus == "Unreachable":
+		r.Recorder.Eventf(&schemaRegistry, nil, corev1.EventTypeNormal, "Recovered", "HealthCheck",
+			"Schema Registry is reachable again (%s)", schemaRegistry.Status.ConnectionStatus)
+	}
+
 	if err := r.Status().Update(ctx, &schemaRegistry); err != nil {
 		log.Error(err, "Failed to update SchemaRegistry status")
 		return ctrl.Result{}, err
 	}
 
 	// Requeue periodically for ongoing health monitoring
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: healthCheckInterval(&schemaRegistry)}, nil
+}
+
+// healthCheckInterval returns the health check interval configured on the SchemaRegistry, defaulting to 5 minutes.
+func healthCheckInterval(sr *registryv1alpha1.SchemaRegistry) time.Duration {
+	interval := time.Duration(sr.Spec.HealthCheckInterval) * time.Second
+	if interval == 0 {
+		interval = 5 * time.Minute
+	}
+	return interval
+}
+
+// registryInfo is the registry state reported in the SchemaRegistry status.
+type registryInfo struct {
+	flavor              string
+	serverVersion       string
+	compatibilityLevel  string
+	mode                string
+	schemaTypes         []string
+	subjectCount        *int
+	latencyMilliseconds *int64
+}
+
+// collectRegistryInfo queries the global configuration and metadata of the registry.
+// Each query is best effort: a failure is logged and leaves its field empty, since
+// not every registry implementation exposes all of them.
+func collectRegistryInfo(ctx context.Context, srClient schemaclient.Registry) registryInfo {
+	log := logf.FromContext(ctx)
+	var info registryInfo
+
+	var err error
+	if info.flavor, err = srClient.DetectFlavor(ctx); err != nil {
+		log.Error(err, "Failed to detect registry flavor")
+	}
+
+	start := time.Now()
+	subjects, err := srClient.ListSubjects(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list subjects")
+	} else {
+		count := len(subjects)
+		latency := time.Since(start).Milliseconds()
+		info.subjectCount = &count
+		info.latencyMilliseconds = &latency
+	}
+
+	if info.compatibilityLevel, err = srClient.GetGlobalCompatibility(ctx); err != nil {
+		log.Error(err, "Failed to get global compatibility level")
+	}
+
+	if info.mode, err = srClient.GetMode(ctx); err != nil {
+		log.Error(err, "Failed to get global mode")
+	}
+
+	if info.schemaTypes, err = srClient.GetSchemaTypes(ctx); err != nil {
+		log.Error(err, "Failed to get supported schema types")
+	}
+
+	version, err := srClient.GetServerVersion(ctx)
+	if err != nil {
+		log.Error(err, "Failed to get server version")
+	} else if version != nil {
+		info.serverVersion = version.Version
+	}
+
+	return info
 }
 
 // setConditionFailed is a helper that sets a Failed status condition and updates the resource.
+// A Warning event with the same reason is emitted when the condition changes.
 func (r *SchemaRegistryReconciler) setConditionFailed(ctx context.Context, sr *registryv1alpha1.SchemaRegistry, reason, message string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetStatus(codes.Error, message)
+	span.SetAttributes(attribute.String("reason", reason))
+
 	// Re-fetch to avoid conflicts
 	if err := r.Get(ctx, client.ObjectKeyFromObject(sr), sr); err != nil {
 		return client.IgnoreNotFound(err)
 	}
 
+	if readyConditionChanged(sr.Status.Conditions, reason, sr.Generation) {
+		r.Recorder.Eventf(sr, nil, corev1.EventTypeWarning, reason, "Reconcile", "%s", message)
+	}
+
 	meta.SetStatusCondition(&sr.Status.Conditions, metav1.Condition{
 		Type:               "Ready",
 		Status:             metav1.ConditionFalse,
@@ -162,21 +405,75 @@ func (r *SchemaRegistryReconciler) findSchemaRegistriesForSecret(ctx context.Con
 	return requests
 }
 
+// findSchemaRegistriesForConfigMap maps a ConfigMap change to SchemaRegistry reconcile requests.
+func (r *SchemaRegistryReconciler) findSchemaRegistriesForConfigMap(ctx context.Context, configMap client.Object) []reconcile.Request {
+	srList := &registryv1alpha1.SchemaRegistryList{}
+	if err := r.List(ctx, srList, client.InNamespace(configMap.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, sr := range srList.Items {
+		if schemaRegistryReferencesConfigMap(&sr, configMap.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: sr.Namespace,
+					Name:      sr.Name,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// schemaRegistryReferencesConfigMap returns true if the SchemaRegistry loads its CA bundle from the given ConfigMap.
+func schemaRegistryReferencesConfigMap(sr *registryv1alpha1.SchemaRegistry, configMapName string) bool {
+	if sr.Spec.TLS == nil || sr.Spec.TLS.CA == nil || sr.Spec.TLS.CA.ConfigMapRef == nil {
+		return false
+	}
+	return sr.Spec.TLS.CA.ConfigMapRef.Name == configMapName
+}
+
 // schemaRegistryReferencesSecret returns true if the SchemaRegistry references the given secret.
 func schemaRegistryReferencesSecret(sr *registryv1alpha1.SchemaRegistry, secretName string) bool {
+	if sr.Spec.TLS != nil && sr.Spec.TLS.CA != nil && sr.Spec.TLS.CA.SecretRef != nil &&
+		sr.Spec.TLS.CA.SecretRef.Name == secretName {
+		return true
+	}
+	if sr.Spec.Proxy != nil && sr.Spec.Proxy.AuthSecretRef != nil && sr.Spec.Proxy.AuthSecretRef.Name == secretName {
+		return true
+	}
+	for _, header := range sr.Spec.Headers {
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef.Name == secretName {
+			return true
+		}
+	}
+	if sr.Spec.Glue != nil && sr.Spec.Glue.CredentialsSecretRef != nil && sr.Spec.Glue.CredentialsSecretRef.Name == secretName {
+		return true
+	}
 	if sr.Spec.Auth == nil {
 		return false
 	}
+	if ref := sr.Spec.Auth.KafkaUserRef; ref != nil {
+		if ref.Name == secretName || ref.ClusterCASecretRef == secretName {
+			return true
+		}
+		// The MTLS client certificate comes from the KafkaUser, but its CA is still trusted
+		return sr.Spec.Auth.Type == registryv1alpha1.AuthTypeMTLS && sr.Spec.Auth.MTLS != nil &&
+			sr.Spec.Auth.MTLS.CASecretRef != nil && sr.Spec.Auth.MTLS.CASecretRef.Name == secretName
+	}
 	switch sr.Spec.Auth.Type {
 	case registryv1alpha1.AuthTypeBasic:
-		return sr.Spec.Auth.BasicAuth != nil && sr.Spec.Auth.BasicAuth.SecretRef == secretName
+		return sr.Spec.Auth.BasicAuth != nil && sr.Spec.Auth.BasicAuth.SecretRef.Name == secretName
 	case registryv1alpha1.AuthTypeBearer:
-		return sr.Spec.Auth.BearerAuth != nil && sr.Spec.Auth.BearerAuth.SecretRef == secretName
+		return sr.Spec.Auth.BearerAuth != nil && sr.Spec.Auth.BearerAuth.SecretRef.Name == secretName
 	case registryv1alpha1.AuthTypeMTLS:
 		if sr.Spec.Auth.MTLS == nil {
 			return false
 		}
-		return sr.Spec.Auth.MTLS.CertSecretRef == secretName || sr.Spec.Auth.MTLS.CASecretRef == secretName
+		if sr.Spec.Auth.MTLS.CertSecretRef.Name == secretName {
+			return true
+		}
+		return sr.Spec.Auth.MTLS.CASecretRef != nil && sr.Spec.Auth.MTLS.CASecretRef.Name == secretName
 	}
 	return false
 }
@@ -189,6 +486,10 @@ func (r *SchemaRegistryReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.findSchemaRegistriesForSecret),
 		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findSchemaRegistriesForConfigMap),
+		).
 		Named("schemaregistry").
 		Complete(r)
 }
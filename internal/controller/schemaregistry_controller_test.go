@@ -18,16 +18,35 @@ package controller
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
 )
 
 var _ = Describe("SchemaRegistry Controller", func() {
@@ -72,8 +91,9 @@ var _ = Describe("SchemaRegistry Controller", func() {
 		It("should successfully reconcile the resource", func() {
 			By("Reconciling the created resource")
 			controllerReconciler := &SchemaRegistryReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
 			}
 
 			// The reconciler performs a health check against a non-existent endpoint.
@@ -90,4 +110,650 @@ var _ = Describe("SchemaRegistry Controller", func() {
 			Expect(updated.Status.ConnectionStatus).NotTo(BeEmpty())
 		})
 	})
+
+	Context("When the registry goes down and recovers", func() {
+		const resourceName = "test-registry-recovery"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *httptest.Server
+		var healthy atomic.Bool
+
+		BeforeEach(func() {
+			healthy.Store(false)
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !healthy.Load() {
+					http.Error(w, "unavailable", http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`[]`))
+			}))
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should emit one Unreachable and one Recovered event", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			By("Failing the health check twice")
+			reconcileOnce()
+			reconcileOnce()
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(HavePrefix("Warning Unreachable"))
+
+			By("Recovering")
+			healthy.Store(true)
+			reconcileOnce()
+			reconcileOnce()
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(HavePrefix("Normal Recovered"))
+		})
+	})
+
+	Context("When the auth Secret is missing a key", func() {
+		const resourceName = "test-registry-missing-key"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vault-creds", Namespace: "default"},
+				Data:       map[string][]byte{"sr.user": []byte("alice")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+			resource := &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "http://schema-registry.test.svc.cluster.local:8081",
+					Auth: &registryv1alpha1.AuthConfig{
+						Type: registryv1alpha1.AuthTypeBasic,
+						BasicAuth: &registryv1alpha1.BasicAuthConfig{
+							SecretRef: registryv1alpha1.BasicAuthSecretRef{
+								Name:        "vault-creds",
+								UsernameKey: "sr.user",
+								PasswordKey: "sr.pass",
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vault-creds", Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should report the missing key instead of using an empty password", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &registryv1alpha1.SchemaRegistry{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal("AuthLoadFailed"))
+			Expect(ready.Message).To(ContainSubstring(`no key "sr.pass"`))
+			Expect(<-recorder.Events).To(HavePrefix("Warning AuthLoadFailed"))
+		})
+	})
+
+	Context("When the proxy credentials Secret is missing", func() {
+		const resourceName = "test-registry-missing-proxy-secret"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "http://schema-registry.test.svc.cluster.local:8081",
+					Proxy: &registryv1alpha1.ProxyConfig{
+						URL:           "http://proxy.test.svc.cluster.local:3128",
+						AuthSecretRef: &registryv1alpha1.BasicAuthSecretRef{Name: "proxy-creds"},
+					},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should trace the failed proxy lookup under the reconcile span", func() {
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var reconcileSpan sdktrace.ReadOnlySpan
+			for _, span := range spanRecorder.Ended() {
+				if span.Name() == "SchemaRegistry.Reconcile" &&
+					slices.Contains(span.Attributes(), attribute.String("k8s.schemaregistry.name", resourceName)) {
+					reconcileSpan = span
+				}
+			}
+			Expect(reconcileSpan).NotTo(BeNil())
+			Expect(reconcileSpan.Status().Code).To(Equal(codes.Error))
+			Expect(reconcileSpan.Attributes()).To(ContainElement(attribute.String("reason", "ProxyLoadFailed")))
+
+			var children []string
+			for _, span := range spanRecorder.Ended() {
+				if span.Parent().SpanID() == reconcileSpan.SpanContext().SpanID() {
+					children = append(children, span.Name())
+					if span.Name() == "loadProxyConfig" {
+						Expect(span.Status().Code).To(Equal(codes.Error))
+						Expect(span.Status().Description).To(ContainSubstring(`"proxy-creds"`))
+					}
+				}
+			}
+			Expect(children).To(Equal([]string{"loadAuthConfig", "loadTLSConfig", "loadProxyConfig"}))
+		})
+	})
+
+	Context("When mapping Secrets to SchemaRegistries", func() {
+		It("should follow the KafkaUser and cluster CA Secrets of a kafkaUserRef", func() {
+			sr := &registryv1alpha1.SchemaRegistry{
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "https://schema-registry.kafka.svc:8081",
+					Auth: &registryv1alpha1.AuthConfig{
+						Type: registryv1alpha1.AuthTypeMTLS,
+						KafkaUserRef: &registryv1alpha1.KafkaUserRef{
+							Name:               "registry-user",
+							ClusterCASecretRef: "my-cluster-cluster-ca-cert",
+						},
+					},
+				},
+			}
+
+			Expect(schemaRegistryReferencesSecret(sr, "registry-user")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "my-cluster-cluster-ca-cert")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "unrelated")).To(BeFalse())
+		})
+
+		It("should follow the MTLS CA Secret combined with a kafkaUserRef", func() {
+			sr := &registryv1alpha1.SchemaRegistry{
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "https://schema-registry.kafka.svc:8081",
+					Auth: &registryv1alpha1.AuthConfig{
+						Type: registryv1alpha1.AuthTypeMTLS,
+						KafkaUserRef: &registryv1alpha1.KafkaUserRef{
+							Name:               "registry-user",
+							ClusterCASecretRef: "my-cluster-cluster-ca-cert",
+						},
+						MTLS: &registryv1alpha1.MTLSConfig{
+							CertSecretRef: registryv1alpha1.TLSSecretRef{Name: "unused-client-cert"},
+							CASecretRef:   &registryv1alpha1.CASecretRef{Name: "registry-ca"},
+						},
+					},
+				},
+			}
+
+			Expect(schemaRegistryReferencesSecret(sr, "registry-user")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "my-cluster-cluster-ca-cert")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "registry-ca")).To(BeTrue())
+			// The client certificate comes from the KafkaUser Secret instead
+			Expect(schemaRegistryReferencesSecret(sr, "unused-client-cert")).To(BeFalse())
+		})
+
+		It("should follow the CA bundle Secret or ConfigMap of spec.tls", func() {
+			sr := &registryv1alpha1.SchemaRegistry{
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "https://schema-registry.kafka.svc:8081",
+					TLS: &registryv1alpha1.TLSConfig{
+						CA: &registryv1alpha1.CABundleSource{
+							ConfigMapRef: &registryv1alpha1.CAConfigMapRef{Name: "internal-ca"},
+						},
+					},
+				},
+			}
+			Expect(schemaRegistryReferencesConfigMap(sr, "internal-ca")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "internal-ca")).To(BeFalse())
+
+			sr.Spec.TLS.CA = &registryv1alpha1.CABundleSource{
+				SecretRef: &registryv1alpha1.CASecretRef{Name: "internal-ca"},
+			}
+			Expect(schemaRegistryReferencesSecret(sr, "internal-ca")).To(BeTrue())
+			Expect(schemaRegistryReferencesConfigMap(sr, "internal-ca")).To(BeFalse())
+		})
+
+		It("should follow the proxy credentials and header value Secrets", func() {
+			sr := &registryv1alpha1.SchemaRegistry{
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "https://psrc-123.europe-west3.gcp.confluent.cloud",
+					Proxy: &registryv1alpha1.ProxyConfig{
+						URL:           "http://proxy.example.com:3128",
+						AuthSecretRef: &registryv1alpha1.BasicAuthSecretRef{Name: "egress-proxy"},
+					},
+					Headers: []registryv1alpha1.HTTPHeader{
+						{Name: "target-sr-cluster", Value: "lsrc-123"},
+						{Name: "X-Api-Key", ValueFrom: &registryv1alpha1.HeaderValueSource{
+							SecretKeyRef: registryv1alpha1.HeaderSecretKeyRef{Name: "sr-api-key", Key: "key"},
+						}},
+					},
+				},
+			}
+
+			Expect(schemaRegistryReferencesSecret(sr, "egress-proxy")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "sr-api-key")).To(BeTrue())
+			Expect(schemaRegistryReferencesSecret(sr, "unrelated")).To(BeFalse())
+		})
+	})
+
+	Context("When the CA bundle cannot be parsed", func() {
+		const resourceName = "test-registry-bad-ca"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "bad-ca", Namespace: "default"},
+				Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydA==\n-----END CERTIFICATE-----\n"},
+			}
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+
+			resource := &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "https://schema-registry.test.svc.cluster.local:8081",
+					TLS: &registryv1alpha1.TLSConfig{
+						CA: &registryv1alpha1.CABundleSource{
+							ConfigMapRef: &registryv1alpha1.CAConfigMapRef{Name: "bad-ca"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "bad-ca", Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should report the parse error instead of ignoring the certificate", func() {
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &registryv1alpha1.SchemaRegistry{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal("TLSLoadFailed"))
+			Expect(ready.Message).To(ContainSubstring(`configmap "bad-ca" key "ca.crt"`))
+		})
+	})
+
+	Context("When both the KafkaUser cluster CA and the MTLS CA are set", func() {
+		ctx := context.Background()
+
+		var clusterCA, mtlsCA *x509.Certificate
+
+		BeforeEach(func() {
+			var clusterCAPEM, mtlsCAPEM []byte
+			clusterCAPEM, clusterCA = testCACertificate("cluster-ca")
+			mtlsCAPEM, mtlsCA = testCACertificate("mtls-ca")
+			Expect(k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster-ca-cert", Namespace: "default"},
+				Data:       map[string][]byte{"ca.crt": clusterCAPEM},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-mtls-ca-cert", Namespace: "default"},
+				Data:       map[string][]byte{"ca.crt": mtlsCAPEM},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			for _, name := range []string{"test-cluster-ca-cert", "test-mtls-ca-cert"} {
+				Expect(k8sClient.Delete(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				})).To(Succeed())
+			}
+		})
+
+		It("should trust both CA bundles", func() {
+			sr := &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-registry-both-ca", Namespace: "default"},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL: "https://schema-registry.test.svc.cluster.local:8081",
+					Auth: &registryv1alpha1.AuthConfig{
+						Type: registryv1alpha1.AuthTypeMTLS,
+						KafkaUserRef: &registryv1alpha1.KafkaUserRef{
+							Name:               "registry-user",
+							ClusterCASecretRef: "test-cluster-ca-cert",
+						},
+						MTLS: &registryv1alpha1.MTLSConfig{
+							CASecretRef: &registryv1alpha1.CASecretRef{Name: "test-mtls-ca-cert"},
+						},
+					},
+				},
+			}
+
+			tlsConfig, err := loadTLSConfig(ctx, k8sClient, sr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tlsConfig.RootCAs).NotTo(BeNil())
+			for _, ca := range []*x509.Certificate{clusterCA, mtlsCA} {
+				_, err := ca.Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs})
+				Expect(err).NotTo(HaveOccurred(), ca.Subject.CommonName)
+			}
+		})
+	})
+
+	Context("When one of several endpoints is unreachable", func() {
+		const resourceName = "test-registry-failover"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var up, down *httptest.Server
+
+		BeforeEach(func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/subjects", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`["orders-value","users-value"]`))
+			})
+			mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"compatibilityLevel":"BACKWARD"}`))
+			})
+			mux.HandleFunc("/mode", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"mode":"READWRITE"}`))
+			})
+			mux.HandleFunc("/schemas/types", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`["JSON","PROTOBUF","AVRO"]`))
+			})
+			up = httptest.NewServer(mux)
+			down = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			down.Close()
+
+			resource := &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URLs:                []string{down.URL, up.URL},
+					HealthCheckInterval: 60,
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			up.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should stay Ready and report each endpoint and the registry state", func() {
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+			updated := &registryv1alpha1.SchemaRegistry{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.ConnectionStatus).To(Equal("Degraded"))
+			ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+			Expect(ready.Reason).To(Equal("Degraded"))
+
+			Expect(updated.Status.Endpoints).To(HaveLen(2))
+			Expect(updated.Status.Endpoints[0].URL).To(Equal(down.URL))
+			Expect(updated.Status.Endpoints[0].Reachable).To(BeFalse())
+			Expect(updated.Status.Endpoints[0].Message).NotTo(BeEmpty())
+			Expect(updated.Status.Endpoints[1].URL).To(Equal(up.URL))
+			Expect(updated.Status.Endpoints[1].Reachable).To(BeTrue())
+
+			Expect(updated.Status.CompatibilityLevel).To(Equal("BACKWARD"))
+			Expect(updated.Status.Mode).To(Equal("READWRITE"))
+			Expect(updated.Status.SchemaTypes).To(ConsistOf("JSON", "PROTOBUF", "AVRO"))
+			Expect(updated.Status.SubjectCount).To(HaveValue(Equal(2)))
+			Expect(updated.Status.LatencyMilliseconds).NotTo(BeNil())
+			Expect(updated.Status.ServerVersion).To(BeEmpty())
+		})
+	})
+
+	Context("When the registry mode is managed", func() {
+		const resourceName = "test-registry-mode"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.Server
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URL:  srv.URL,
+					Mode: registryv1alpha1.RegistryModeReadOnly,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should apply spec.mode and restore it after an outside change", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			reconcileOnce()
+			Expect(srv.Mode("")).To(Equal("READONLY"))
+			updated := &registryv1alpha1.SchemaRegistry{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.Mode).To(Equal("READONLY"))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, "ModeApplied")).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring("ModeChanged")))
+
+			By("Keeping the mode without further events")
+			reconcileOnce()
+			Expect(recorder.Events).NotTo(Receive())
+
+			By("Restoring the mode after an outside change")
+			Expect(srv.SetMode("", "READWRITE")).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Mode("")).To(Equal("READONLY"))
+		})
+	})
+
+	Context("When the failover strategy is RoundRobin", func() {
+		const registryName = "test-registry-round-robin"
+
+		ctx := context.Background()
+
+		var (
+			first, second *httptest.Server
+			hits          [2]atomic.Int32
+		)
+
+		BeforeEach(func() {
+			newServer := func(i int) *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					hits[i].Add(1)
+					_, _ = w.Write([]byte(`[]`))
+				}))
+			}
+			first, second = newServer(0), newServer(1)
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaRegistrySpec{
+					URLs:             []string{first.URL, second.URL},
+					FailoverStrategy: registryv1alpha1.FailoverStrategyRoundRobin,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			first.Close()
+			second.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should continue the rotation across clients built for each reconcile", func() {
+			ref := registryv1alpha1.SchemaRegistryRef{Name: registryName}
+			for range 2 {
+				srClient, err := BuildRegistryClient(ctx, k8sClient, "default", ref)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = srClient.ListSubjects(ctx)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(hits[0].Load()).To(Equal(int32(1)))
+			Expect(hits[1].Load()).To(Equal(int32(1)))
+		})
+
+		It("should forget the rotation of a deleted registry and restart it for a recreated one", func() {
+			gone := types.NamespacedName{Name: "test-registry-round-robin-gone", Namespace: "default"}
+			deleted := &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: gone.Name, Namespace: gone.Namespace, UID: "old"},
+			}
+			roundRobinCounter(deleted).Add(3)
+
+			recreated := deleted.DeepCopy()
+			recreated.UID = "new"
+			Expect(roundRobinCounter(recreated).Load()).To(BeZero())
+
+			controllerReconciler := &SchemaRegistryReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: gone})
+			Expect(err).NotTo(HaveOccurred())
+			_, found := roundRobinCounters.Load(gone)
+			Expect(found).To(BeFalse())
+		})
+	})
 })
+
+// testCACertificate returns a self-signed CA certificate in PEM and parsed form.
+func testCACertificate(commonName string) ([]byte, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), cert
+}
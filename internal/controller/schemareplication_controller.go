@@ -0,0 +1,436 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+)
+
+// maxReplicationErrors limits the failed subjects listed in the SchemaReplication status.
+const maxReplicationErrors = 10
+
+// SchemaReplicationReconciler reconciles a SchemaReplication object
+type SchemaReplicationReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemareplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemareplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile copies the versions of the selected subjects that are missing in the target
+// registry, in version order, and reports the outcome in the status. It re-queues after
+// spec.syncInterval (5 minutes by default), unless spec.suspend is set. Nothing is deleted
+// from the target, neither when a source subject is deleted nor with the SchemaReplication.
+func (r *SchemaReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracer.Start(ctx, "SchemaReplication.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.schemareplication.name", req.Name),
+	))
+	defer span.End()
+
+	log := logf.FromContext(ctx)
+
+	var replication registryv1alpha1.SchemaReplication
+	if err := r.Get(ctx, req.NamespacedName, &replication); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	span.SetAttributes(
+		attribute.String("schemareplication.source", registryRefKey(replication.Namespace, replication.Spec.SourceRef)),
+		attribute.String("schemareplication.target", registryRefKey(replication.Namespace, replication.Spec.TargetRef)),
+	)
+
+	wasSuspended := meta.IsStatusConditionTrue(replication.Status.Conditions, "Suspended")
+	if updateSuspendedCondition(&replication.Status.Conditions, replication.Spec.Suspend, replication.Generation) {
+		if err := r.Status().Update(ctx, &replication); err != nil {
+			log.Error(err, "Failed to update SchemaReplication status")
+			return ctrl.Result{}, err
+		}
+		switch {
+		case replication.Spec.Suspend && !wasSuspended:
+			r.Recorder.Eventf(&replication, nil, corev1.EventTypeNormal, "Suspended", "Suspend",
+				"Replication is suspended")
+		case !replication.Spec.Suspend && wasSuspended:
+			r.Recorder.Eventf(&replication, nil, corev1.EventTypeNormal, "Resumed", "Resume",
+				"Replication is resumed")
+		}
+	}
+	if replication.Spec.Suspend {
+		log.Info("SchemaReplication reconciliation is suspended")
+		return ctrl.Result{}, nil
+	}
+
+	interval := time.Duration(replication.Spec.SyncInterval) * time.Second
+	if interval == 0 {
+		interval = 5 * time.Minute
+	}
+
+	include, exclude, err := compileSubjectFilter(replication.Spec.Subjects)
+	if err != nil {
+		return ctrl.Result{}, r.setConditionFailed(ctx, &replication, "InvalidFilter", err.Error())
+	}
+
+	source, err := BuildRegistryClient(ctx, r.Client, replication.Namespace, replication.Spec.SourceRef)
+	if err != nil {
+		log.Error(err, "Failed to build source Schema Registry client")
+		return ctrl.Result{RequeueAfter: interval}, r.setConditionFailed(ctx, &replication, "ClientBuildFailed", err.Error())
+	}
+	target, err := BuildRegistryClient(ctx, r.Client, replication.Namespace, replication.Spec.TargetRef)
+	if err != nil {
+		log.Error(err, "Failed to build target Schema Registry client")
+		return ctrl.Result{RequeueAfter: interval}, r.setConditionFailed(ctx, &replication, "ClientBuildFailed", err.Error())
+	}
+
+	subjects, err := source.ListSubjects(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list source subjects")
+		return ctrl.Result{RequeueAfter: interval}, r.setConditionFailed(ctx, &replication, "SourceUnavailable", err.Error())
+	}
+	subjects = slices.DeleteFunc(subjects, func(subject string) bool {
+		return !matchesAny(include, subject, true) || matchesAny(exclude, subject, false)
+	})
+	slices.Sort(subjects)
+
+	replicator := newReplicator(source, target, replication.Spec.PreserveIDs)
+	var failures []registryv1alpha1.ReplicationError
+	lag := 0
+	for _, subject := range subjects {
+		if err := replicator.syncSubject(ctx, subject); err != nil {
+			log.Error(err, "Failed to replicate subject", "subject", subject)
+			failures = append(failures, registryv1alpha1.ReplicationError{Subject: subject, Message: err.Error()})
+			lag += replicator.lag(subject)
+		}
+	}
+	log.Info("Replication finished", "subjects", len(subjects), "copied", replicator.copied, "failed", len(failures))
+
+	// Re-fetch before status update to avoid conflicts
+	if err := r.Get(ctx, req.NamespacedName, &replication); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if replicator.copied > 0 {
+		r.Recorder.Eventf(&replication, nil, corev1.EventTypeNormal, "Replicated", "Replicate",
+			"Copied %d versions to the target registry", replicator.copied)
+	}
+
+	now := metav1.Now()
+	replication.Status.Subjects = len(subjects)
+	replication.Status.CopiedVersions = replicator.copied
+	replication.Status.Lag = lag
+	replication.Status.Errors = failures[:min(len(failures), maxReplicationErrors)]
+	replication.Status.LastSyncTime = &now
+	replication.Status.ObservedGeneration = replication.Generation
+
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Synced",
+		Message:            fmt.Sprintf("%d subjects replicated", len(subjects)),
+		ObservedGeneration: replication.Generation,
+	}
+	if len(failures) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ReplicationFailed"
+		condition.Message = fmt.Sprintf("%d of %d subjects failed to replicate, %s: %s",
+			len(failures), len(subjects), failures[0].Subject, failures[0].Message)
+		span.SetStatus(codes.Error, condition.Message)
+		if readyConditionChanged(replication.Status.Conditions, condition.Reason, replication.Generation) {
+			r.Recorder.Eventf(&replication, nil, corev1.EventTypeWarning, condition.Reason, "Reconcile", "%s", condition.Message)
+		}
+	}
+	meta.SetStatusCondition(&replication.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, &replication); err != nil {
+		log.Error(err, "Failed to update SchemaReplication status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// setConditionFailed sets a failed status condition and updates the resource.
+// A Warning event with the same reason is emitted when the condition changes.
+func (r *SchemaReplicationReconciler) setConditionFailed(ctx context.Context, replication *registryv1alpha1.SchemaReplication, reason, message string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetStatus(codes.Error, message)
+	span.SetAttributes(attribute.String("reason", reason))
+
+	// Re-fetch to avoid conflicts
+	if err := r.Get(ctx, client.ObjectKeyFromObject(replication), replication); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if readyConditionChanged(replication.Status.Conditions, reason, replication.Generation) {
+		r.Recorder.Eventf(replication, nil, corev1.EventTypeWarning, reason, "Reconcile", "%s", message)
+	}
+
+	meta.SetStatusCondition(&replication.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: replication.Generation,
+	})
+
+	return r.Status().Update(ctx, replication)
+}
+
+// compileSubjectFilter compiles the include and exclude expressions of the filter.
+func compileSubjectFilter(filter registryv1alpha1.SubjectFilter) (include, exclude []*regexp.Regexp, err error) {
+	if include, err = compilePatterns(filter.Include); err != nil {
+		return nil, nil, fmt.Errorf("invalid include pattern: %w", err)
+	}
+	if exclude, err = compilePatterns(filter.Exclude); err != nil {
+		return nil, nil, fmt.Errorf("invalid exclude pattern: %w", err)
+	}
+	return include, exclude, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled[i] = re
+	}
+	return compiled, nil
+}
+
+// matchesAny reports whether subject matches one of the expressions, or returns empty
+// when there are none.
+func matchesAny(patterns []*regexp.Regexp, subject string, empty bool) bool {
+	if len(patterns) == 0 {
+		return empty
+	}
+	return slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool { return re.MatchString(subject) })
+}
+
+// replicator copies subject versions from the source to the target registry during one
+// synchronization. It remembers the versions found or registered in the target, so
+// subjects shared through references are only checked once.
+type replicator struct {
+	source, target schemaclient.Registry
+	preserveIDs    bool
+	// versions caches the version numbers of source subjects
+	versions map[string][]int
+	// synced maps a source subject and version to the version number in the target
+	synced map[string]map[int]int
+	// copied counts the versions registered in the target
+	copied int
+}
+
+func newReplicator(source, target schemaclient.Registry, preserveIDs bool) *replicator {
+	return &replicator{
+		source:      source,
+		target:      target,
+		preserveIDs: preserveIDs,
+		versions:    map[string][]int{},
+		synced:      map[string]map[int]int{},
+	}
+}
+
+// syncSubject brings the subject in the target up to the latest source version. When the
+// latest version is already in the target the subject is considered in sync; otherwise the
+// missing versions are copied in order.
+func (r *replicator) syncSubject(ctx context.Context, subject string) error {
+	versions, err := r.sourceVersions(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+	_, err = r.syncVersion(ctx, subject, versions[len(versions)-1])
+	return err
+}
+
+// syncVersion makes sure the source version is registered in the target and returns its
+// version number there. Earlier versions of the subject and the referenced schemas are
+// copied first.
+func (r *replicator) syncVersion(ctx context.Context, subject string, version int) (int, error) {
+	if targetVersion, ok := r.synced[subject][version]; ok {
+		return targetVersion, nil
+	}
+
+	schema, err := r.source.GetSchema(ctx, subject, strconv.Itoa(version))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read version %d of subject %s: %w", version, subject, err)
+	}
+
+	request := schemaclient.RegisterSchemaRequest{
+		Schema:     schema.Schema,
+		SchemaType: schema.SchemaType,
+	}
+	if request.SchemaType == "" {
+		// The registry leaves out the schema type of AVRO schemas
+		request.SchemaType = string(registryv1alpha1.SchemaTypeAvro)
+	}
+	// Referenced versions can have other numbers in the target
+	for _, ref := range schema.References {
+		refVersion, err := r.syncVersion(ctx, ref.Subject, ref.Version)
+		if err != nil {
+			return 0, fmt.Errorf("reference %s: %w", ref.Name, err)
+		}
+		request.References = append(request.References, schemaclient.SchemaReference{
+			Name:    ref.Name,
+			Subject: ref.Subject,
+			Version: refVersion,
+		})
+	}
+
+	resp, err := r.target.LookupSchema(ctx, subject, request)
+	switch {
+	case err == nil:
+		if r.preserveIDs && resp.ID != schema.ID {
+			return 0, fmt.Errorf("version %d of subject %s is registered in the target with ID %d instead of %d",
+				version, subject, resp.ID, schema.ID)
+		}
+	case schemaclient.IsSubjectNotFound(err) || schemaclient.IsSchemaNotFound(err):
+		if err := r.syncEarlierVersions(ctx, subject, version); err != nil {
+			return 0, err
+		}
+		if r.preserveIDs {
+			resp, err = importSchema(ctx, r.target, subject, request, schema.ID, "")
+		} else {
+			resp, err = r.target.RegisterSchema(ctx, subject, request)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to copy version %d of subject %s: %w", version, subject, err)
+		}
+		r.copied++
+	default:
+		return 0, fmt.Errorf("failed to look up version %d of subject %s in the target: %w", version, subject, err)
+	}
+
+	if r.synced[subject] == nil {
+		r.synced[subject] = map[int]int{}
+	}
+	r.synced[subject][version] = resp.Version
+	return resp.Version, nil
+}
+
+// syncEarlierVersions copies the source versions of the subject before version, so the
+// target receives them in the same order.
+func (r *replicator) syncEarlierVersions(ctx context.Context, subject string, version int) error {
+	versions, err := r.sourceVersions(ctx, subject)
+	if err != nil {
+		return err
+	}
+	for _, earlier := range versions {
+		if earlier >= version {
+			break
+		}
+		if _, err := r.syncVersion(ctx, subject, earlier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *replicator) sourceVersions(ctx context.Context, subject string) ([]int, error) {
+	if versions, ok := r.versions[subject]; ok {
+		return versions, nil
+	}
+	versions, err := r.source.GetSubjectVersions(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of subject %s: %w", subject, err)
+	}
+	slices.Sort(versions)
+	r.versions[subject] = versions
+	return versions, nil
+}
+
+// lag returns the number of source versions of the subject not confirmed in the target.
+func (r *replicator) lag(subject string) int {
+	lag := 0
+	for _, version := range r.versions[subject] {
+		if _, ok := r.synced[subject][version]; !ok {
+			lag++
+		}
+	}
+	return lag
+}
+
+// findReplicationsForRegistry maps a SchemaRegistry change to SchemaReplication reconcile requests.
+func (r *SchemaReplicationReconciler) findReplicationsForRegistry(ctx context.Context, registry client.Object) []reconcile.Request {
+	replicationList := &registryv1alpha1.SchemaReplicationList{}
+	if err := r.List(ctx, replicationList); err != nil {
+		return nil
+	}
+	key := registry.GetNamespace() + "/" + registry.GetName()
+	var requests []reconcile.Request
+	for _, replication := range replicationList.Items {
+		if registryRefKey(replication.Namespace, replication.Spec.SourceRef) == key ||
+			registryRefKey(replication.Namespace, replication.Spec.TargetRef) == key {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: replication.Namespace,
+					Name:      replication.Name,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SchemaReplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// The status is updated on every sync, so only spec and annotation changes start another one
+		For(&registryv1alpha1.SchemaReplication{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}),
+		)).
+		// Health checks update the registry status every few minutes, only spec changes start a sync
+		Watches(
+			&registryv1alpha1.SchemaRegistry{},
+			handler.EnqueueRequestsFromMapFunc(r.findReplicationsForRegistry),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Named("schemareplication").
+		Complete(r)
+}
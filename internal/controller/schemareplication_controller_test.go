@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
+)
+
+var _ = Describe("SchemaReplication Controller", func() {
+	Context("When replicating between two registries", func() {
+		const resourceName = "test-replication"
+		const sourceName = "test-replication-source"
+		const targetName = "test-replication-target"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var source, target *registrytest.Server
+
+		BeforeEach(func() {
+			source = registrytest.NewServer()
+			target = registrytest.NewServer()
+
+			// orders-value references the second version of common-value
+			Expect(source.SetCompatibility("common-value", "NONE")).To(Succeed())
+			for _, schema := range []string{
+				`{"type":"fixed","name":"Id","size":4}`,
+				`{"type":"fixed","name":"Id","size":8}`,
+			} {
+				_, _, err := source.Register("common-value", registrytest.RegisterRequest{Schema: schema})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			_, _, err := source.Register("orders-value", registrytest.RegisterRequest{
+				Schema:     `{"type":"record","name":"Order","fields":[{"name":"id","type":"Id"}]}`,
+				References: []registrytest.Reference{{Name: "Id", Subject: "common-value", Version: 2}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = source.Register("orders-test", registrytest.RegisterRequest{Schema: `"string"`})
+			Expect(err).NotTo(HaveOccurred())
+
+			for name, srv := range map[string]*registrytest.Server{sourceName: source, targetName: target} {
+				Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+				})).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaReplication{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.SchemaReplicationSpec{
+					SourceRef: registryv1alpha1.SchemaRegistryRef{Name: sourceName},
+					TargetRef: registryv1alpha1.SchemaRegistryRef{Name: targetName},
+					Subjects: registryv1alpha1.SubjectFilter{
+						Include: []string{`^orders-`},
+						Exclude: []string{`-test$`},
+					},
+					PreserveIDs: true,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			source.Close()
+			target.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaReplication{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			for _, name := range []string{sourceName, targetName} {
+				Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				})).To(Succeed())
+			}
+		})
+
+		It("should copy the selected subjects and their references in order", func() {
+			controllerReconciler := &SchemaReplicationReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			By("Copying the missing versions")
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).NotTo(BeZero())
+			Expect(target.Versions("common-value")).To(Equal([]int{1, 2}))
+			Expect(target.Versions("orders-value")).To(Equal([]int{1}))
+			Expect(target.Versions("orders-test")).To(BeEmpty())
+
+			resource := &registryv1alpha1.SchemaReplication{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.Subjects).To(Equal(1))
+			Expect(resource.Status.CopiedVersions).To(Equal(3))
+			Expect(resource.Status.Lag).To(BeZero())
+
+			By("Copying nothing once the target is in sync")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.CopiedVersions).To(BeZero())
+		})
+	})
+})
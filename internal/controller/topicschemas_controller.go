@@ -0,0 +1,417 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
+)
+
+const topicSchemasFinalizer = "registry.strimzi.io/topicschemas-finalizer"
+
+// TopicSchemasReconciler reconciles a TopicSchemas object
+type TopicSchemasReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+}
+
+// topicSubject is one side (key or value) of a TopicSchemas resource.
+type topicSubject struct {
+	// part is either "key" or "value"
+	part       string
+	subject    string
+	definition *registryv1alpha1.TopicSchemaDefinition
+}
+
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=topicschemas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=topicschemas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=topicschemas/finalizers,verbs=update
+// +kubebuilder:rbac:groups=registry.strimzi.io,resources=schemaregistries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile registers the key and value schemas of a topic as one unit.
+// Both schemas are checked for compatibility before either is registered, so a
+// rejected value schema does not leave a new key version behind. Glue has no dry run
+// and checks compatibility only on registration, so there the pair is not atomic: the
+// key can be registered before Glue rejects the value. Registered subjects
+// are recorded in the status; a subject that drops out of the spec is deleted, and on
+// deletion all recorded subjects are removed together unless the deletion policy is Retain.
+func (r *TopicSchemasReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracer.Start(ctx, "TopicSchemas.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.topicschemas.name", req.Name),
+	))
+	defer span.End()
+
+	log := logf.FromContext(ctx)
+
+	var topicSchemas registryv1alpha1.TopicSchemas
+	if err := r.Get(ctx, req.NamespacedName, &topicSchemas); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	span.SetAttributes(
+		attribute.String("topic", topicSchemas.Spec.Topic),
+		registryRefAttribute(topicSchemas.Namespace, topicSchemas.Spec.RegistryRef),
+	)
+
+	// --- Deletion path ---
+	if !topicSchemas.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(&topicSchemas, topicSchemasFinalizer) {
+			if topicSchemas.Spec.DeletionPolicy != registryv1alpha1.DeletionPolicyRetain {
+				log.Info("Deleting topic subjects from registry", "topic", topicSchemas.Spec.Topic)
+
+				if err := r.deleteFromRegistry(ctx, &topicSchemas); err != nil {
+					log.Error(err, "Failed to delete topic subjects from registry")
+					return ctrl.Result{}, err
+				}
+			}
+
+			controllerutil.RemoveFinalizer(&topicSchemas, topicSchemasFinalizer)
+			if err := r.Update(ctx, &topicSchemas); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// --- Add finalizer if missing ---
+	if !controllerutil.ContainsFinalizer(&topicSchemas, topicSchemasFinalizer) {
+		controllerutil.AddFinalizer(&topicSchemas, topicSchemasFinalizer)
+		if err := r.Update(ctx, &topicSchemas); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Re-fetch after update
+		if err := r.Get(ctx, req.NamespacedName, &topicSchemas); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
+	// --- Build Schema Registry client ---
+	srClient, err := BuildRegistryClient(ctx, r.Client, topicSchemas.Namespace, topicSchemas.Spec.RegistryRef)
+	if err != nil {
+		log.Error(err, "Failed to build Schema Registry client")
+		return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &topicSchemas, clientBuildFailedReason(err), err.Error())
+	}
+
+	subjects := topicSubjects(&topicSchemas)
+
+	// --- Check compatibility of every subject before registering any ---
+	// GlueClient reports every schema as compatible, so on Glue this check passes and
+	// an incompatible value is only rejected after the key has been registered.
+	for _, ts := range subjects {
+		compatible, err := srClient.CheckCompatibility(ctx, ts.subject, topicRegisterRequest(ts.definition))
+		if err != nil {
+			log.Error(err, "Failed to check compatibility", "subject", ts.subject)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &topicSchemas, "CompatibilityCheckFailed", err.Error())
+		}
+		if !compatible {
+			msg := fmt.Sprintf("%s schema is not compatible with the latest version of subject %q", ts.part, ts.subject)
+			log.Info("Schema rejected by compatibility check", "subject", ts.subject)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &topicSchemas, "Incompatible", msg)
+		}
+	}
+
+	// --- Register schemas ---
+	registered := make(map[string]*schemaclient.SchemaResponse, len(subjects))
+	compatibilityApplied := map[string]bool{}
+	for _, ts := range subjects {
+		log.Info("Registering schema", "subject", ts.subject, "type", ts.definition.SchemaType)
+
+		resp, err := srClient.RegisterSchema(ctx, ts.subject, topicRegisterRequest(ts.definition))
+		if err != nil {
+			log.Error(err, "Failed to register schema", "subject", ts.subject)
+			return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &topicSchemas, "RegistrationFailed", err.Error())
+		}
+		registered[ts.part] = resp
+
+		if err := r.recordSubject(ctx, &topicSchemas, ts.subject); err != nil {
+			log.Error(err, "Failed to record registered subject", "subject", ts.subject)
+			return ctrl.Result{}, err
+		}
+
+		if ts.definition.CompatibilityLevel != "" {
+			if err := srClient.SetCompatibility(ctx, ts.subject, ts.definition.CompatibilityLevel); err != nil {
+				log.Error(err, "Failed to set compatibility level", "subject", ts.subject, "level", ts.definition.CompatibilityLevel)
+				// Non-fatal: log but continue - schema is already registered
+			} else {
+				compatibilityApplied[ts.part] = true
+			}
+		}
+	}
+
+	// --- Delete subjects that dropped out of the spec ---
+	current := make([]string, 0, len(subjects))
+	for _, ts := range subjects {
+		current = append(current, ts.subject)
+	}
+	if topicSchemas.Spec.DeletionPolicy != registryv1alpha1.DeletionPolicyRetain {
+		for _, subject := range registeredSubjects(&topicSchemas) {
+			if slices.Contains(current, subject) {
+				continue
+			}
+			log.Info("Deleting subject removed from spec", "subject", subject)
+			if err := srClient.DeleteSubject(ctx, subject); err != nil {
+				log.Error(err, "Failed to delete subject removed from spec", "subject", subject)
+				return ctrl.Result{RequeueAfter: time.Minute}, r.setConditionFailed(ctx, &topicSchemas, "SubjectDeletionFailed", err.Error())
+			}
+			r.Recorder.Eventf(&topicSchemas, nil, corev1.EventTypeNormal, "SubjectDeleted", "Delete",
+				"Deleted subject %s removed from the spec", subject)
+		}
+	}
+
+	// --- Update status ---
+	// Re-fetch before status update to avoid conflicts
+	if err := r.Get(ctx, req.NamespacedName, &topicSchemas); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	now := metav1.Now()
+	key := subjectStatus(topicSchemas.Spec.Topic+"-key", registered["key"], now)
+	value := subjectStatus(topicSchemas.Spec.Topic+"-value", registered["value"], now)
+	r.recordRegistration(&topicSchemas, topicSchemas.Status.Key, key, topicSchemas.Spec.Key, compatibilityApplied["key"])
+	r.recordRegistration(&topicSchemas, topicSchemas.Status.Value, value, topicSchemas.Spec.Value, compatibilityApplied["value"])
+	topicSchemas.Status.Key = key
+	topicSchemas.Status.Value = value
+	topicSchemas.Status.Subjects = current
+	topicSchemas.Status.ObservedGeneration = topicSchemas.Generation
+
+	parts := make([]string, 0, len(subjects))
+	for _, ts := range subjects {
+		resp := registered[ts.part]
+		parts = append(parts, fmt.Sprintf("%s ID %d version %d", ts.part, resp.ID, resp.Version))
+	}
+
+	meta.SetStatusCondition(&topicSchemas.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Registered",
+		Message:            "Schemas registered: " + strings.Join(parts, ", "),
+		ObservedGeneration: topicSchemas.Generation,
+	})
+
+	if err := r.Status().Update(ctx, &topicSchemas); err != nil {
+		log.Error(err, "Failed to update TopicSchemas status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Topic schemas successfully registered", "topic", topicSchemas.Spec.Topic)
+	return ctrl.Result{}, nil
+}
+
+// deleteFromRegistry deletes the subjects recorded in the status from Schema Registry
+// during CR deletion.
+func (r *TopicSchemasReconciler) deleteFromRegistry(ctx context.Context, topicSchemas *registryv1alpha1.TopicSchemas) error {
+	srClient, err := BuildRegistryClient(ctx, r.Client, topicSchemas.Namespace, topicSchemas.Spec.RegistryRef)
+	if err != nil {
+		// If the registry itself is gone, we can still proceed with finalizer removal
+		logf.FromContext(ctx).Info("Could not build client during deletion, skipping registry cleanup", "error", err.Error())
+		return nil
+	}
+
+	for _, subject := range registeredSubjects(topicSchemas) {
+		if err := srClient.DeleteSubject(ctx, subject); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(topicSchemas, nil, corev1.EventTypeNormal, "SubjectDeleted", "Delete",
+			"Deleted subject %s from Schema Registry", subject)
+	}
+	return nil
+}
+
+// recordRegistration emits the events of a key or value registration and carries the applied
+// compatibility level of definition over to the new status. previous is the status before the
+// reconcile and current the status of the registered subject, nil when the spec does not declare
+// it. Unchanged subjects emit nothing, so repeated reconciles stay quiet.
+func (r *TopicSchemasReconciler) recordRegistration(topicSchemas *registryv1alpha1.TopicSchemas,
+	previous, current *registryv1alpha1.SubjectStatus, definition *registryv1alpha1.TopicSchemaDefinition, compatibilityApplied bool) {
+	if current == nil {
+		return
+	}
+	// A renamed topic registers a different subject
+	if previous == nil || previous.Subject != current.Subject {
+		previous = &registryv1alpha1.SubjectStatus{}
+	}
+
+	switch {
+	case previous.SchemaID == nil:
+		r.Recorder.Eventf(topicSchemas, nil, corev1.EventTypeNormal, "Registered", "Register",
+			"Registered subject %s with schema ID %d, version %d", current.Subject, *current.SchemaID, *current.Version)
+	case *previous.SchemaID != *current.SchemaID || previous.Version == nil || *previous.Version != *current.Version:
+		r.Recorder.Eventf(topicSchemas, nil, corev1.EventTypeNormal, "NewVersion", "Register",
+			"Registered version %d of subject %s with schema ID %d", *current.Version, current.Subject, *current.SchemaID)
+	}
+
+	current.CompatibilityLevel = previous.CompatibilityLevel
+	if !compatibilityApplied {
+		return
+	}
+	if previous.CompatibilityLevel != definition.CompatibilityLevel {
+		r.Recorder.Eventf(topicSchemas, nil, corev1.EventTypeNormal, "CompatibilityChanged", "SetCompatibility",
+			"Compatibility level of subject %s set to %s", current.Subject, definition.CompatibilityLevel)
+	}
+	current.CompatibilityLevel = definition.CompatibilityLevel
+}
+
+// recordSubject adds a just registered subject to status.subjects, so it is cleaned up
+// even when a later step of the reconcile fails.
+func (r *TopicSchemasReconciler) recordSubject(ctx context.Context, topicSchemas *registryv1alpha1.TopicSchemas, subject string) error {
+	if slices.Contains(topicSchemas.Status.Subjects, subject) {
+		return nil
+	}
+	topicSchemas.Status.Subjects = append(topicSchemas.Status.Subjects, subject)
+	return r.Status().Update(ctx, topicSchemas)
+}
+
+// setConditionFailed sets a failed status condition and updates the resource.
+// A Warning event with the same reason is emitted when the condition changes.
+func (r *TopicSchemasReconciler) setConditionFailed(ctx context.Context, topicSchemas *registryv1alpha1.TopicSchemas, reason, message string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetStatus(codes.Error, message)
+	span.SetAttributes(attribute.String("reason", reason))
+
+	// Re-fetch to avoid conflicts
+	if err := r.Get(ctx, client.ObjectKeyFromObject(topicSchemas), topicSchemas); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if readyConditionChanged(topicSchemas.Status.Conditions, reason, topicSchemas.Generation) {
+		r.Recorder.Eventf(topicSchemas, nil, corev1.EventTypeWarning, reason, "Reconcile", "%s", message)
+	}
+
+	meta.SetStatusCondition(&topicSchemas.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: topicSchemas.Generation,
+	})
+
+	return r.Status().Update(ctx, topicSchemas)
+}
+
+// topicSubjects returns the key and value subjects declared by the resource, key first.
+func topicSubjects(topicSchemas *registryv1alpha1.TopicSchemas) []topicSubject {
+	var subjects []topicSubject
+	if topicSchemas.Spec.Key != nil {
+		subjects = append(subjects, topicSubject{
+			part:       "key",
+			subject:    topicSchemas.Spec.Topic + "-key",
+			definition: topicSchemas.Spec.Key,
+		})
+	}
+	if topicSchemas.Spec.Value != nil {
+		subjects = append(subjects, topicSubject{
+			part:       "value",
+			subject:    topicSchemas.Spec.Topic + "-value",
+			definition: topicSchemas.Spec.Value,
+		})
+	}
+	return subjects
+}
+
+// registeredSubjects returns the subjects the resource has registered. Besides
+// status.subjects it includes the key and value subjects of the status, which cover
+// resources last reconciled before status.subjects was recorded.
+func registeredSubjects(topicSchemas *registryv1alpha1.TopicSchemas) []string {
+	subjects := slices.Clone(topicSchemas.Status.Subjects)
+	for _, st := range []*registryv1alpha1.SubjectStatus{topicSchemas.Status.Key, topicSchemas.Status.Value} {
+		if st != nil && st.Subject != "" && !slices.Contains(subjects, st.Subject) {
+			subjects = append(subjects, st.Subject)
+		}
+	}
+	return subjects
+}
+
+// topicRegisterRequest builds the registry request for a key or value schema definition.
+func topicRegisterRequest(definition *registryv1alpha1.TopicSchemaDefinition) schemaclient.RegisterSchemaRequest {
+	return schemaclient.RegisterSchemaRequest{
+		Schema:     definition.Schema,
+		SchemaType: string(definition.SchemaType),
+		References: convertReferences(definition.References),
+	}
+}
+
+// subjectStatus converts a registration response into a SubjectStatus.
+// It returns nil when the subject was not part of the reconciled spec.
+func subjectStatus(subject string, resp *schemaclient.SchemaResponse, now metav1.Time) *registryv1alpha1.SubjectStatus {
+	if resp == nil {
+		return nil
+	}
+	id, version := resp.ID, resp.Version
+	return &registryv1alpha1.SubjectStatus{
+		Subject:      subject,
+		SchemaID:     &id,
+		Version:      &version,
+		RegisteredAt: &now,
+	}
+}
+
+// findTopicSchemasForRegistry maps a SchemaRegistry change to TopicSchemas reconcile requests.
+func (r *TopicSchemasReconciler) findTopicSchemasForRegistry(ctx context.Context, registry client.Object) []reconcile.Request {
+	topicSchemasList := &registryv1alpha1.TopicSchemasList{}
+	if err := r.List(ctx, topicSchemasList, client.InNamespace(registry.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, topicSchemas := range topicSchemasList.Items {
+		if topicSchemas.Spec.RegistryRef.Name == registry.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: topicSchemas.Namespace,
+					Name:      topicSchemas.Name,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TopicSchemasReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&registryv1alpha1.TopicSchemas{}).
+		Watches(
+			&registryv1alpha1.SchemaRegistry{},
+			handler.EnqueueRequestsFromMapFunc(r.findTopicSchemasForRegistry),
+		).
+		Named("topicschemas").
+		Complete(r)
+}
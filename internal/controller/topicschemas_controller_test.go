@@ -0,0 +1,278 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
+)
+
+var _ = Describe("TopicSchemas Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-topic-schemas"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		topicSchemas := &registryv1alpha1.TopicSchemas{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind TopicSchemas")
+			err := k8sClient.Get(ctx, typeNamespacedName, topicSchemas)
+			if err != nil && errors.IsNotFound(err) {
+				resource := &registryv1alpha1.TopicSchemas{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: registryv1alpha1.TopicSchemasSpec{
+						Topic: "orders",
+						Key: &registryv1alpha1.TopicSchemaDefinition{
+							SchemaType: registryv1alpha1.SchemaTypeAvro,
+							Schema:     `"string"`,
+						},
+						Value: &registryv1alpha1.TopicSchemaDefinition{
+							SchemaType: registryv1alpha1.SchemaTypeAvro,
+							Schema:     `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`,
+						},
+						RegistryRef: registryv1alpha1.SchemaRegistryRef{
+							Name: "test-registry",
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &registryv1alpha1.TopicSchemas{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if errors.IsNotFound(err) {
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Removing finalizer so the resource can be deleted")
+			resource.Finalizers = nil
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance TopicSchemas")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should add a finalizer and set a failed status condition when registry is not found", func() {
+			controllerReconciler := &TopicSchemasReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			By("First reconcile: adds finalizer, registry not found -> sets failed condition")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &registryv1alpha1.TopicSchemas{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Finalizers).To(ContainElement(topicSchemasFinalizer))
+			Expect(updated.Status.Conditions).NotTo(BeEmpty())
+			Expect(updated.Status.Conditions[0].Reason).To(Equal("ClientBuildFailed"))
+		})
+
+		It("should release the finalizer on deletion when the deletion policy is Retain", func() {
+			controllerReconciler := &TopicSchemasReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Switching to the Retain policy and deleting the resource")
+			resource := &registryv1alpha1.TopicSchemas{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.DeletionPolicy = registryv1alpha1.DeletionPolicyRetain
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying the resource is gone")
+			err = k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When the registry accepts the schemas", func() {
+		const resourceName = "test-topic-schemas-registered"
+		const registryName = "test-registry-topic"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		var srv *registrytest.Server
+
+		BeforeEach(func() {
+			srv = registrytest.NewServer()
+
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+				Spec:       registryv1alpha1.SchemaRegistrySpec{URL: srv.URL},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &registryv1alpha1.TopicSchemas{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: registryv1alpha1.TopicSchemasSpec{
+					Topic: "payments",
+					Key: &registryv1alpha1.TopicSchemaDefinition{
+						SchemaType: registryv1alpha1.SchemaTypeAvro,
+						Schema:     `"string"`,
+					},
+					Value: &registryv1alpha1.TopicSchemaDefinition{
+						SchemaType:         registryv1alpha1.SchemaTypeAvro,
+						Schema:             `{"type":"record","name":"Payment","fields":[{"name":"id","type":"string"}]}`,
+						CompatibilityLevel: "FULL",
+					},
+					RegistryRef: registryv1alpha1.SchemaRegistryRef{Name: registryName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			srv.Close()
+			Expect(k8sClient.Delete(ctx, &registryv1alpha1.SchemaRegistry{
+				ObjectMeta: metav1.ObjectMeta{Name: registryName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should register both subjects, reject incompatible changes and delete them together", func() {
+			recorder := events.NewFakeRecorder(10)
+			controllerReconciler := &TopicSchemasReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.TopicSchemas{}
+
+			By("Registering the key and value schemas")
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.Key.SchemaID).To(HaveValue(Equal(1)))
+			Expect(resource.Status.Value.SchemaID).To(HaveValue(Equal(2)))
+			Expect(resource.Status.Value.Version).To(HaveValue(Equal(1)))
+			Expect(srv.Versions("payments-key")).To(Equal([]int{1}))
+			Expect(srv.Requests()).To(ContainElement("PUT /config/payments-value"))
+			Expect(<-recorder.Events).To(Equal("Normal Registered Registered subject payments-key with schema ID 1, version 1"))
+			Expect(<-recorder.Events).To(Equal("Normal Registered Registered subject payments-value with schema ID 2, version 1"))
+			Expect(<-recorder.Events).To(Equal("Normal CompatibilityChanged Compatibility level of subject payments-value set to FULL"))
+			Expect(resource.Status.Value.CompatibilityLevel).To(Equal("FULL"))
+
+			By("Staying quiet when nothing changed")
+			reconcileOnce()
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Rejecting a value schema that breaks FULL compatibility")
+			resource.Spec.Value.Schema = `{"type":"record","name":"Payment","fields":[` +
+				`{"name":"id","type":"string"},{"name":"amount","type":"long"}]}`
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			ready := meta.FindStatusCondition(resource.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal("Incompatible"))
+			Expect(srv.Versions("payments-value")).To(Equal([]int{1}))
+			Expect(<-recorder.Events).To(HavePrefix("Warning Incompatible"))
+
+			By("Deleting both subjects on finalization")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions("payments-key")).To(BeEmpty())
+			Expect(srv.Versions("payments-value")).To(BeEmpty())
+			Expect(<-recorder.Events).To(HavePrefix("Normal SubjectDeleted"))
+			Expect(<-recorder.Events).To(HavePrefix("Normal SubjectDeleted"))
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should delete a subject that drops out of the spec", func() {
+			controllerReconciler := &TopicSchemasReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: events.NewFakeRecorder(10),
+			}
+			reconcileOnce := func() {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			resource := &registryv1alpha1.TopicSchemas{}
+
+			By("Recording both registered subjects in the status")
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Subjects).To(Equal([]string{"payments-key", "payments-value"}))
+
+			By("Deleting the key subject once the key is removed from the spec")
+			resource.Spec.Key = nil
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.Subjects).To(Equal([]string{"payments-value"}))
+			Expect(resource.Status.Key).To(BeNil())
+			Expect(srv.Versions("payments-key")).To(BeEmpty())
+			Expect(srv.Versions("payments-value")).To(Equal([]int{1}))
+
+			By("Deleting the remaining subject on finalization")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			reconcileOnce()
+			Expect(srv.Versions("payments-value")).To(BeEmpty())
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
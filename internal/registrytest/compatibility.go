@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hamba/avro/v2"
+)
+
+// compatibleWith checks candidate against the versions of a subject under level.
+// Non-transitive levels only look at the latest version; with transitive false
+// the check is always limited to the versions given, as for the compatibility
+// endpoint of one specific version.
+func (r *Registry) compatibleWith(level string, candidate *schemaRecord, versions []*subjectVersion, transitive bool) bool {
+	if level == CompatibilityNone || len(versions) == 0 {
+		return true
+	}
+	if !transitive || !strings.HasSuffix(level, "_TRANSITIVE") {
+		versions = versions[len(versions)-1:]
+	}
+	backward := strings.HasPrefix(level, "BACKWARD") || strings.HasPrefix(level, "FULL")
+	forward := strings.HasPrefix(level, "FORWARD") || strings.HasPrefix(level, "FULL")
+
+	for _, v := range versions {
+		existing := r.schemas[v.id]
+		if existing.schemaType != candidate.schemaType {
+			return false
+		}
+		if candidate.schemaType != "AVRO" {
+			continue
+		}
+		if backward && r.avroCompatible(candidate, existing) != nil {
+			return false
+		}
+		if forward && r.avroCompatible(existing, candidate) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// avroCompatible reports whether data written with writer can be read with reader.
+func (r *Registry) avroCompatible(reader, writer *schemaRecord) error {
+	readerSchema, err := r.parseAvro(reader)
+	if err != nil {
+		return err
+	}
+	writerSchema, err := r.parseAvro(writer)
+	if err != nil {
+		return err
+	}
+	return avro.NewSchemaCompatibility().Compatible(readerSchema, writerSchema)
+}
+
+// parseAvro parses an AVRO schema with the named types of its references,
+// each parse using its own cache so that versions of the same record do not clash.
+func (r *Registry) parseAvro(record *schemaRecord) (avro.Schema, error) {
+	cache := &avro.SchemaCache{}
+	if err := r.loadAvroReferences(record.references, cache, map[Reference]bool{}); err != nil {
+		return nil, err
+	}
+	return avro.ParseWithCache(record.schema, "", cache)
+}
+
+func (r *Registry) loadAvroReferences(refs []Reference, cache *avro.SchemaCache, loaded map[Reference]bool) error {
+	for _, ref := range refs {
+		if loaded[ref] {
+			continue
+		}
+		loaded[ref] = true
+		referenced, err := r.lookupReference(ref)
+		if err != nil {
+			return err
+		}
+		if err := r.loadAvroReferences(referenced.references, cache, loaded); err != nil {
+			return err
+		}
+		if _, err := avro.ParseWithCache(referenced.schema, "", cache); err != nil {
+			return fmt.Errorf("reference %s: %w", ref.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest
+
+import (
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Exporter is a schema exporter of Schema Linking, the body of POST /exporters. The
+// registry does not export anything, it keeps the configuration and state of its exporters.
+type Exporter struct {
+	Name                string            `json:"name"`
+	ContextType         string            `json:"contextType,omitempty"`
+	Context             string            `json:"context,omitempty"`
+	Subjects            []string          `json:"subjects,omitempty"`
+	SubjectRenameFormat string            `json:"subjectRenameFormat,omitempty"`
+	Config              map[string]string `json:"config,omitempty"`
+}
+
+// ExporterStatus is the response of GET /exporters/{name}/status.
+type ExporterStatus struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Offset int64  `json:"offset"`
+	TS     int64  `json:"ts"`
+	Trace  string `json:"trace,omitempty"`
+}
+
+type exporter struct {
+	Exporter
+	status ExporterStatus
+}
+
+// GetExporter returns the exporter and its status, or false when it does not exist.
+func (r *Registry) GetExporter(name string) (Exporter, ExporterStatus, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e := r.exporters[name]
+	if e == nil {
+		return Exporter{}, ExporterStatus{}, false
+	}
+	return e.Exporter, e.status, true
+}
+
+// SetExporterState changes the state, offset and error trace of an existing exporter,
+// e.g. to ERROR to simulate an unreachable destination.
+func (r *Registry) SetExporterState(name, state string, offset int64, trace string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e := r.exporters[name]; e != nil {
+		e.setState(state)
+		e.status.Offset = offset
+		e.status.Trace = trace
+	}
+}
+
+func (e *exporter) setState(state string) {
+	e.status.State = state
+	e.status.TS = time.Now().UnixMilli()
+	e.status.Trace = ""
+}
+
+func (r *Registry) findExporter(req *http.Request) (*exporter, *Error) {
+	name := req.PathValue("name")
+	if e := r.exporters[name]; e != nil {
+		return e, nil
+	}
+	return nil, newError(http.StatusNotFound, 40450, "Exporter '%s' not found", name)
+}
+
+func (r *Registry) listExporters(*http.Request) (any, *Error) {
+	names := make([]string, 0, len(r.exporters))
+	for name := range r.exporters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (r *Registry) createExporter(req *http.Request) (any, *Error) {
+	var body Exporter
+	if err := decodeBody(req, &body); err != nil {
+		return nil, err
+	}
+	if body.Name == "" {
+		return nil, newError(http.StatusUnprocessableEntity, 42250, "Exporter name is missing")
+	}
+	if body.Config["schema.registry.url"] == "" {
+		return nil, newError(http.StatusUnprocessableEntity, 42250, "Exporter config schema.registry.url is missing")
+	}
+	if r.exporters[body.Name] != nil {
+		return nil, newError(http.StatusConflict, 40950, "Exporter '%s' already exists", body.Name)
+	}
+	if body.ContextType == "" {
+		body.ContextType = "AUTO"
+	}
+	e := &exporter{Exporter: body, status: ExporterStatus{Name: body.Name}}
+	e.setState("RUNNING")
+	r.exporters[body.Name] = e
+	return map[string]string{"name": body.Name}, nil
+}
+
+func (r *Registry) getExporter(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	return e.Exporter, nil
+}
+
+func (r *Registry) getExporterConfig(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	return e.Config, nil
+}
+
+// updateExporter implements PUT /exporters/{name}. Fields left out of the body keep their value.
+func (r *Registry) updateExporter(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	var body Exporter
+	if err := decodeBody(req, &body); err != nil {
+		return nil, err
+	}
+	if body.ContextType != "" {
+		e.ContextType = body.ContextType
+	}
+	if body.Context != "" {
+		e.Context = body.Context
+	}
+	if body.Subjects != nil {
+		e.Subjects = body.Subjects
+	}
+	if body.SubjectRenameFormat != "" {
+		e.SubjectRenameFormat = body.SubjectRenameFormat
+	}
+	if body.Config != nil {
+		e.Config = body.Config
+	}
+	return map[string]string{"name": e.Name}, nil
+}
+
+func (r *Registry) getExporterStatus(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	return e.status, nil
+}
+
+func (r *Registry) pauseExporter(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	e.setState("PAUSED")
+	return map[string]string{"name": e.Name}, nil
+}
+
+func (r *Registry) resumeExporter(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	if e.status.State != "PAUSED" {
+		return nil, newError(http.StatusConflict, 40951, "Exporter '%s' is not paused", e.Name)
+	}
+	e.setState("RUNNING")
+	return map[string]string{"name": e.Name}, nil
+}
+
+func (r *Registry) deleteExporter(req *http.Request) (any, *Error) {
+	e, err := r.findExporter(req)
+	if err != nil {
+		return nil, err
+	}
+	delete(r.exporters, e.Name)
+	return map[string]string{"name": e.Name}, nil
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// glueCompatibility maps Glue compatibility modes to the levels of the registry.
+var glueCompatibility = map[string]string{
+	"NONE":         CompatibilityNone,
+	"BACKWARD":     CompatibilityBackward,
+	"BACKWARD_ALL": CompatibilityBackwardTransitive,
+	"FORWARD":      CompatibilityForward,
+	"FORWARD_ALL":  CompatibilityForwardTransitive,
+	"FULL":         CompatibilityFull,
+	"FULL_ALL":     CompatibilityFullTransitive,
+}
+
+// glueVersion is a schema version known by its SchemaVersionId. Versions that failed
+// the compatibility check are only kept here, like Glue keeps them with status FAILURE.
+type glueVersion struct {
+	subject string
+	version int
+	failed  bool
+	record  *schemaRecord
+}
+
+// glueRequest holds the request fields of the Glue operations served by GlueServer.
+type glueRequest struct {
+	RegistryID struct {
+		RegistryName string `json:"RegistryName"`
+	} `json:"RegistryId"`
+	SchemaID struct {
+		RegistryName string `json:"RegistryName"`
+		SchemaName   string `json:"SchemaName"`
+	} `json:"SchemaId"`
+	SchemaName          string `json:"SchemaName"`
+	DataFormat          string `json:"DataFormat"`
+	Compatibility       string `json:"Compatibility"`
+	SchemaDefinition    string `json:"SchemaDefinition"`
+	SchemaVersionID     string `json:"SchemaVersionId"`
+	SchemaVersionNumber struct {
+		LatestVersion bool `json:"LatestVersion"`
+		VersionNumber int  `json:"VersionNumber"`
+	} `json:"SchemaVersionNumber"`
+}
+
+// GlueServer serves the AWS Glue Schema Registry API (JSON 1.1 protocol) on a local
+// HTTP server, backed by a Registry. Glue schemas in RegistryName are the subjects of
+// the Registry, so tests can seed and inspect it with the Registry methods. New versions
+// are reported PENDING and settle to AVAILABLE or FAILURE on the next GetSchemaVersion.
+type GlueServer struct {
+	*httptest.Server
+	*Registry
+	// RegistryName is the only Glue registry served, "default-registry"
+	RegistryName string
+
+	// accessKeyID and versions are guarded by the Registry lock
+	accessKeyID string
+	versions    map[string]*glueVersion
+}
+
+// NewGlueServer starts a new Glue stand-in on a local HTTP server. The caller closes it when done.
+func NewGlueServer() *GlueServer {
+	g := &GlueServer{
+		Registry:     New(),
+		RegistryName: "default-registry",
+		versions:     map[string]*glueVersion{},
+	}
+	g.Server = httptest.NewServer(http.HandlerFunc(g.serve))
+	return g
+}
+
+// RequireSigV4 rejects requests that are not signed with Signature Version 4 for the glue
+// service by accessKeyID. The signature itself is not verified.
+func (g *GlueServer) RequireSigV4(accessKeyID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.accessKeyID = accessKeyID
+}
+
+func (g *GlueServer) serve(w http.ResponseWriter, req *http.Request) {
+	operation, ok := strings.CutPrefix(req.Header.Get("X-Amz-Target"), "AWSGlue.")
+	if req.Method != http.MethodPost || !ok {
+		writeGlueError(w, http.StatusBadRequest, "UnknownOperationException", "Unknown operation")
+		return
+	}
+	var in glueRequest
+	if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+		writeGlueError(w, http.StatusBadRequest, "SerializationException", err.Error())
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.requests = append(g.requests, "POST "+operation)
+
+	if g.accessKeyID != "" {
+		auth := req.Header.Get("Authorization")
+		prefix := "AWS4-HMAC-SHA256 Credential=" + g.accessKeyID + "/"
+		if !strings.HasPrefix(auth, prefix) || !strings.Contains(auth, "/glue/aws4_request") {
+			writeGlueError(w, http.StatusForbidden, "AccessDeniedException", "Missing or invalid signature")
+			return
+		}
+	}
+
+	var (
+		out any
+		err *glueError
+	)
+	switch operation {
+	case "GetRegistry":
+		out, err = g.getRegistry(in)
+	case "ListSchemas":
+		out, err = g.listSchemas(in)
+	case "CreateSchema":
+		out, err = g.createSchema(in)
+	case "GetSchema":
+		out, err = g.getSchema(in)
+	case "UpdateSchema":
+		out, err = g.updateSchema(in)
+	case "DeleteSchema":
+		out, err = g.deleteSchema(in)
+	case "RegisterSchemaVersion":
+		out, err = g.registerSchemaVersion(in)
+	case "GetSchemaVersion":
+		out, err = g.getSchemaVersion(in)
+	case "GetSchemaByDefinition":
+		out, err = g.getSchemaByDefinition(in)
+	case "ListSchemaVersions":
+		out, err = g.listSchemaVersions(in)
+	default:
+		err = &glueError{"InvalidInputException", "Unsupported operation " + operation}
+	}
+	if err != nil {
+		writeGlueError(w, http.StatusBadRequest, err.errorType, err.message)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+type glueError struct {
+	errorType string
+	message   string
+}
+
+func notFound(format string, args ...any) *glueError {
+	return &glueError{"EntityNotFoundException", fmt.Sprintf(format, args...)}
+}
+
+func writeGlueError(w http.ResponseWriter, statusCode int, errorType, message string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-ErrorType", errorType)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]string{"__type": errorType, "Message": message})
+}
+
+func (g *GlueServer) checkRegistry(name string) *glueError {
+	if name != g.RegistryName {
+		return notFound("Registry is not found. RegistryName: %s", name)
+	}
+	return nil
+}
+
+// schema returns the subject of an existing Glue schema.
+func (g *GlueServer) schema(in glueRequest) (*subject, *glueError) {
+	if err := g.checkRegistry(in.SchemaID.RegistryName); err != nil {
+		return nil, err
+	}
+	s, err := g.getSubject(in.SchemaID.SchemaName, false)
+	if err != nil {
+		return nil, notFound("Schema is not found. RegistryName: %s, SchemaName: %s", g.RegistryName, in.SchemaID.SchemaName)
+	}
+	return s, nil
+}
+
+// versionID returns the SchemaVersionId of a version, recording it for later lookups.
+func (g *GlueServer) versionID(v *glueVersion) string {
+	id := fmt.Sprintf("%08x-0000-4000-8000-%012x", crc32.ChecksumIEEE([]byte(v.subject)), v.version)
+	g.versions[id] = v
+	return id
+}
+
+func (g *GlueServer) liveVersion(name string, v *subjectVersion) *glueVersion {
+	return &glueVersion{subject: name, version: v.version, record: g.schemas[v.id]}
+}
+
+func (g *GlueServer) getRegistry(in glueRequest) (any, *glueError) {
+	if err := g.checkRegistry(in.RegistryID.RegistryName); err != nil {
+		return nil, err
+	}
+	return map[string]string{"RegistryName": g.RegistryName, "Status": "AVAILABLE"}, nil
+}
+
+func (g *GlueServer) listSchemas(in glueRequest) (any, *glueError) {
+	if err := g.checkRegistry(in.RegistryID.RegistryName); err != nil {
+		return nil, err
+	}
+	schemas := []map[string]string{}
+	for _, name := range g.subjectNames(false) {
+		schemas = append(schemas, map[string]string{
+			"RegistryName": g.RegistryName, "SchemaName": name, "SchemaStatus": "AVAILABLE",
+		})
+	}
+	return map[string]any{"Schemas": schemas}, nil
+}
+
+func (g *GlueServer) createSchema(in glueRequest) (any, *glueError) {
+	if err := g.checkRegistry(in.RegistryID.RegistryName); err != nil {
+		return nil, err
+	}
+	if _, err := g.getSubject(in.SchemaName, false); err == nil {
+		return nil, &glueError{"AlreadyExistsException", "Schema already exists. SchemaName: " + in.SchemaName}
+	}
+	if in.Compatibility != "" {
+		level, ok := glueCompatibility[in.Compatibility]
+		if !ok {
+			return nil, &glueError{"InvalidInputException", "Invalid compatibility " + in.Compatibility}
+		}
+		if err := g.setCompatibility(in.SchemaName, level); err != nil {
+			return nil, &glueError{"InvalidInputException", err.Message}
+		}
+	}
+	id, version, err := g.register(in.SchemaName, RegisterRequest{Schema: in.SchemaDefinition, SchemaType: in.DataFormat})
+	if err != nil {
+		return nil, &glueError{"InvalidInputException", err.Message}
+	}
+	return map[string]any{
+		"RegistryName":        g.RegistryName,
+		"SchemaName":          in.SchemaName,
+		"DataFormat":          g.schemas[id].schemaType,
+		"Compatibility":       g.compatibilityMode(in.SchemaName),
+		"SchemaStatus":        "AVAILABLE",
+		"LatestSchemaVersion": version,
+		"SchemaVersionId":     g.versionID(&glueVersion{subject: in.SchemaName, version: version, record: g.schemas[id]}),
+		"SchemaVersionStatus": "AVAILABLE",
+	}, nil
+}
+
+func (g *GlueServer) compatibilityMode(name string) string {
+	level := g.effectiveCompatibility(name)
+	for mode, l := range glueCompatibility {
+		if l == level {
+			return mode
+		}
+	}
+	return level
+}
+
+func (g *GlueServer) getSchema(in glueRequest) (any, *glueError) {
+	s, err := g.schema(in)
+	if err != nil {
+		return nil, err
+	}
+	live := s.liveVersions(false)
+	return map[string]any{
+		"RegistryName":        g.RegistryName,
+		"SchemaName":          in.SchemaID.SchemaName,
+		"DataFormat":          g.schemas[live[len(live)-1].id].schemaType,
+		"Compatibility":       g.compatibilityMode(in.SchemaID.SchemaName),
+		"SchemaStatus":        "AVAILABLE",
+		"LatestSchemaVersion": live[len(live)-1].version,
+	}, nil
+}
+
+func (g *GlueServer) updateSchema(in glueRequest) (any, *glueError) {
+	if _, err := g.schema(in); err != nil {
+		return nil, err
+	}
+	level, ok := glueCompatibility[in.Compatibility]
+	if !ok {
+		return nil, &glueError{"InvalidInputException", "Invalid compatibility " + in.Compatibility}
+	}
+	if err := g.setCompatibility(in.SchemaID.SchemaName, level); err != nil {
+		return nil, &glueError{"InvalidInputException", err.Message}
+	}
+	return map[string]string{"RegistryName": g.RegistryName, "SchemaName": in.SchemaID.SchemaName}, nil
+}
+
+// deleteSchema removes the schema with all its versions at once, as Glue does.
+func (g *GlueServer) deleteSchema(in glueRequest) (any, *glueError) {
+	if _, err := g.schema(in); err != nil {
+		return nil, err
+	}
+	delete(g.subjects, in.SchemaID.SchemaName)
+	g.dropUnusedSchemas()
+	return map[string]string{"SchemaName": in.SchemaID.SchemaName, "Status": "DELETING"}, nil
+}
+
+func (g *GlueServer) registerSchemaVersion(in glueRequest) (any, *glueError) {
+	s, gerr := g.schema(in)
+	if gerr != nil {
+		return nil, gerr
+	}
+	live := s.liveVersions(false)
+	schemaType := g.schemas[live[len(live)-1].id].schemaType
+
+	v := &glueVersion{subject: in.SchemaID.SchemaName}
+	id, version, err := g.register(v.subject, RegisterRequest{Schema: in.SchemaDefinition, SchemaType: schemaType})
+	switch {
+	case err == nil:
+		v.version, v.record = version, g.schemas[id]
+	case err.StatusCode == http.StatusConflict:
+		v.version = s.versions[len(s.versions)-1].version + 1
+		v.failed = true
+		v.record = &schemaRecord{schemaType: schemaType, schema: in.SchemaDefinition}
+	default:
+		return nil, &glueError{"InvalidInputException", err.Message}
+	}
+	return map[string]any{"SchemaVersionId": g.versionID(v), "VersionNumber": v.version, "Status": "PENDING"}, nil
+}
+
+func (g *GlueServer) getSchemaVersion(in glueRequest) (any, *glueError) {
+	var v *glueVersion
+	if in.SchemaVersionID != "" {
+		v = g.versions[in.SchemaVersionID]
+		if v == nil {
+			return nil, notFound("Schema version is not found. SchemaVersionId: %s", in.SchemaVersionID)
+		}
+	} else {
+		s, err := g.schema(in)
+		if err != nil {
+			return nil, err
+		}
+		for _, sv := range s.liveVersions(false) {
+			if in.SchemaVersionNumber.LatestVersion || sv.version == in.SchemaVersionNumber.VersionNumber {
+				v = g.liveVersion(in.SchemaID.SchemaName, sv)
+			}
+		}
+		if v == nil {
+			return nil, notFound("Schema version is not found. VersionNumber: %d", in.SchemaVersionNumber.VersionNumber)
+		}
+	}
+
+	status := "AVAILABLE"
+	if v.failed {
+		status = "FAILURE"
+	}
+	return map[string]any{
+		"SchemaVersionId":  g.versionID(v),
+		"SchemaDefinition": v.record.schema,
+		"DataFormat":       v.record.schemaType,
+		"VersionNumber":    v.version,
+		"Status":           status,
+	}, nil
+}
+
+func (g *GlueServer) getSchemaByDefinition(in glueRequest) (any, *glueError) {
+	s, err := g.schema(in)
+	if err != nil {
+		return nil, err
+	}
+	for _, sv := range s.liveVersions(false) {
+		record := g.schemas[sv.id]
+		if sameSchema(record, &schemaRecord{schemaType: record.schemaType, schema: in.SchemaDefinition}) {
+			v := g.liveVersion(in.SchemaID.SchemaName, sv)
+			return map[string]any{"SchemaVersionId": g.versionID(v), "DataFormat": record.schemaType, "Status": "AVAILABLE"}, nil
+		}
+	}
+	return nil, notFound("Schema is not found. SchemaName: %s", in.SchemaID.SchemaName)
+}
+
+func (g *GlueServer) listSchemaVersions(in glueRequest) (any, *glueError) {
+	s, err := g.schema(in)
+	if err != nil {
+		return nil, err
+	}
+	versions := []map[string]any{}
+	for _, sv := range s.liveVersions(false) {
+		versions = append(versions, map[string]any{
+			"SchemaVersionId": g.versionID(g.liveVersion(in.SchemaID.SchemaName, sv)),
+			"VersionNumber":   sv.version,
+			"Status":          "AVAILABLE",
+		})
+	}
+	return map[string]any{"Schemas": versions}, nil
+}
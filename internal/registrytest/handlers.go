@@ -0,0 +1,463 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+// schemaResponse is the body of GET /subjects/{subject}/versions/{version} and POST /subjects/{subject}.
+type schemaResponse struct {
+	Subject    string      `json:"subject,omitempty"`
+	ID         int         `json:"id"`
+	Version    int         `json:"version,omitempty"`
+	SchemaType string      `json:"schemaType,omitempty"`
+	Schema     string      `json:"schema"`
+	References []Reference `json:"references,omitempty"`
+}
+
+func (r *Registry) routes() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, _ *http.Request) { writeJSON(w, struct{}{}) })
+	mux.HandleFunc("GET /v1/metadata/version", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]string{"version": "7.6.0", "commitId": "registrytest"})
+	})
+	mux.HandleFunc("GET /schemas/types", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, []string{"JSON", "PROTOBUF", "AVRO"})
+	})
+	mux.HandleFunc("GET /schemas/ids/{id}", r.locked(r.getSchemaByID))
+	mux.HandleFunc("GET /schemas/ids/{id}/versions", r.locked(r.getSchemaVersions))
+
+	mux.HandleFunc("GET /subjects", r.locked(r.listSubjects))
+	mux.HandleFunc("POST /subjects/{subject}", r.locked(r.lookupSchema))
+	mux.HandleFunc("DELETE /subjects/{subject}", r.locked(r.deleteSubject))
+	mux.HandleFunc("GET /subjects/{subject}/versions", r.locked(r.listVersions))
+	mux.HandleFunc("POST /subjects/{subject}/versions", r.locked(r.registerSchema))
+	mux.HandleFunc("GET /subjects/{subject}/versions/{version}", r.locked(r.getVersion))
+	mux.HandleFunc("GET /subjects/{subject}/versions/{version}/schema", r.locked(r.getVersionSchema))
+	mux.HandleFunc("DELETE /subjects/{subject}/versions/{version}", r.locked(r.deleteVersion))
+
+	mux.HandleFunc("POST /compatibility/subjects/{subject}/versions", r.locked(r.checkCompatibility))
+	mux.HandleFunc("POST /compatibility/subjects/{subject}/versions/{version}", r.locked(r.checkCompatibility))
+
+	mux.HandleFunc("GET /config", r.locked(r.getConfig))
+	mux.HandleFunc("PUT /config", r.locked(r.putConfig))
+	mux.HandleFunc("GET /config/{subject}", r.locked(r.getConfig))
+	mux.HandleFunc("PUT /config/{subject}", r.locked(r.putConfig))
+	mux.HandleFunc("DELETE /config/{subject}", r.locked(r.deleteConfig))
+
+	mux.HandleFunc("GET /mode", r.locked(r.getMode))
+	mux.HandleFunc("PUT /mode", r.locked(r.putMode))
+	mux.HandleFunc("GET /mode/{subject}", r.locked(r.getMode))
+	mux.HandleFunc("PUT /mode/{subject}", r.locked(r.putMode))
+	mux.HandleFunc("DELETE /mode/{subject}", r.locked(r.deleteMode))
+
+	mux.HandleFunc("GET /exporters", r.locked(r.listExporters))
+	mux.HandleFunc("POST /exporters", r.locked(r.createExporter))
+	mux.HandleFunc("GET /exporters/{name}", r.locked(r.getExporter))
+	mux.HandleFunc("PUT /exporters/{name}", r.locked(r.updateExporter))
+	mux.HandleFunc("DELETE /exporters/{name}", r.locked(r.deleteExporter))
+	mux.HandleFunc("GET /exporters/{name}/config", r.locked(r.getExporterConfig))
+	mux.HandleFunc("GET /exporters/{name}/status", r.locked(r.getExporterStatus))
+	mux.HandleFunc("PUT /exporters/{name}/pause", r.locked(r.pauseExporter))
+	mux.HandleFunc("PUT /exporters/{name}/resume", r.locked(r.resumeExporter))
+	r.mux = mux
+}
+
+// locked serves a handler that returns its response body or a registry error while holding the lock.
+func (r *Registry) locked(handler func(*http.Request) (any, *Error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		body, err := handler(req)
+		r.mu.Unlock()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, body)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, err *Error) {
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	w.WriteHeader(err.StatusCode)
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+func decodeBody(req *http.Request, out any) *Error {
+	if err := json.NewDecoder(req.Body).Decode(out); err != nil {
+		return newError(http.StatusBadRequest, http.StatusBadRequest, "Invalid request body: %v", err)
+	}
+	return nil
+}
+
+func includeDeleted(req *http.Request) bool {
+	return req.URL.Query().Get("deleted") == "true"
+}
+
+func (r *Registry) response(subjectName string, v *subjectVersion) schemaResponse {
+	record := r.schemas[v.id]
+	resp := schemaResponse{
+		Subject:    subjectName,
+		ID:         v.id,
+		Version:    v.version,
+		Schema:     record.schema,
+		References: record.references,
+	}
+	// Like Confluent Schema Registry, the default type is left out
+	if record.schemaType != "AVRO" {
+		resp.SchemaType = record.schemaType
+	}
+	return resp
+}
+
+// findVersion resolves a version path segment: a number, "latest" or -1.
+func (r *Registry) findVersion(req *http.Request, deleted bool) (string, *subjectVersion, *Error) {
+	name := req.PathValue("subject")
+	s, err := r.getSubject(name, deleted)
+	if err != nil {
+		return name, nil, err
+	}
+	versions := s.liveVersions(deleted)
+
+	param := req.PathValue("version")
+	if param == "latest" || param == "-1" {
+		return name, versions[len(versions)-1], nil
+	}
+	number, convErr := strconv.Atoi(param)
+	if convErr != nil || number < 1 {
+		return name, nil, newError(http.StatusUnprocessableEntity, 42202,
+			"The specified version '%s' is not a valid version id. Allowed values are between [1, 2^31-1] and the string \"latest\"", param)
+	}
+	for _, v := range versions {
+		if v.version == number {
+			return name, v, nil
+		}
+	}
+	return name, nil, newError(http.StatusNotFound, 40402, "Version %d not found.", number)
+}
+
+func (r *Registry) getSchemaByID(req *http.Request) (any, *Error) {
+	id, _ := strconv.Atoi(req.PathValue("id"))
+	record := r.schemas[id]
+	if record == nil {
+		return nil, newError(http.StatusNotFound, 40403, "Schema %s not found", req.PathValue("id"))
+	}
+	resp := schemaResponse{ID: id, Schema: record.schema, References: record.references}
+	if record.schemaType != "AVRO" {
+		resp.SchemaType = record.schemaType
+	}
+	return resp, nil
+}
+
+func (r *Registry) getSchemaVersions(req *http.Request) (any, *Error) {
+	id, _ := strconv.Atoi(req.PathValue("id"))
+	if r.schemas[id] == nil {
+		return nil, newError(http.StatusNotFound, 40403, "Schema %s not found", req.PathValue("id"))
+	}
+	type subjectVersionResponse struct {
+		Subject string `json:"subject"`
+		Version int    `json:"version"`
+	}
+	result := []subjectVersionResponse{}
+	for _, name := range r.subjectNames(includeDeleted(req)) {
+		for _, v := range r.subjects[name].liveVersions(includeDeleted(req)) {
+			if v.id == id {
+				result = append(result, subjectVersionResponse{Subject: name, Version: v.version})
+			}
+		}
+	}
+	return result, nil
+}
+
+// subjectNames lists the subjects with versions in a stable order.
+func (r *Registry) subjectNames(deleted bool) []string {
+	names := []string{}
+	for name, s := range r.subjects {
+		if len(s.liveVersions(deleted)) > 0 {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (r *Registry) listSubjects(req *http.Request) (any, *Error) {
+	return r.subjectNames(includeDeleted(req)), nil
+}
+
+func (r *Registry) listVersions(req *http.Request) (any, *Error) {
+	s, err := r.getSubject(req.PathValue("subject"), includeDeleted(req))
+	if err != nil {
+		return nil, err
+	}
+	versions := []int{}
+	for _, v := range s.liveVersions(includeDeleted(req)) {
+		versions = append(versions, v.version)
+	}
+	return versions, nil
+}
+
+func (r *Registry) getVersion(req *http.Request) (any, *Error) {
+	name, v, err := r.findVersion(req, includeDeleted(req))
+	if err != nil {
+		return nil, err
+	}
+	return r.response(name, v), nil
+}
+
+func (r *Registry) getVersionSchema(req *http.Request) (any, *Error) {
+	_, v, err := r.findVersion(req, includeDeleted(req))
+	if err != nil {
+		return nil, err
+	}
+	// The schema is returned as-is, it is JSON for AVRO and JSON Schema
+	return json.RawMessage(r.schemas[v.id].schema), nil
+}
+
+func (r *Registry) registerSchema(req *http.Request) (any, *Error) {
+	var request RegisterRequest
+	if err := decodeBody(req, &request); err != nil {
+		return nil, err
+	}
+	id, _, err := r.register(req.PathValue("subject"), request)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]int{"id": id}, nil
+}
+
+func (r *Registry) lookupSchema(req *http.Request) (any, *Error) {
+	var request RegisterRequest
+	if err := decodeBody(req, &request); err != nil {
+		return nil, err
+	}
+	name := req.PathValue("subject")
+	s, err := r.getSubject(name, includeDeleted(req))
+	if err != nil {
+		return nil, err
+	}
+	candidate := &schemaRecord{schemaType: request.SchemaType, schema: request.Schema, references: request.References}
+	if candidate.schemaType == "" {
+		candidate.schemaType = "AVRO"
+	}
+	for _, v := range s.liveVersions(includeDeleted(req)) {
+		if sameSchema(r.schemas[v.id], candidate) {
+			return r.response(name, v), nil
+		}
+	}
+	return nil, newError(http.StatusNotFound, 40403, "Schema not found")
+}
+
+// deleteSubject soft-deletes all versions of a subject, or removes them with
+// permanent=true once they have been soft-deleted.
+func (r *Registry) deleteSubject(req *http.Request) (any, *Error) {
+	name := req.PathValue("subject")
+	if err := r.checkWritable(name); err != nil {
+		return nil, err
+	}
+	s, err := r.getSubject(name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []int{}
+	if req.URL.Query().Get("permanent") == "true" {
+		if len(s.liveVersions(false)) > 0 {
+			return nil, newError(http.StatusNotFound, 40405,
+				"Subject '%s' was not deleted first before being permanently deleted", name)
+		}
+		for _, v := range s.versions {
+			versions = append(versions, v.version)
+		}
+		s.versions = nil
+		r.dropUnusedSchemas()
+		return versions, nil
+	}
+
+	live := s.liveVersions(false)
+	if len(live) == 0 {
+		return nil, newError(http.StatusNotFound, 40404,
+			"Subject '%s' was soft deleted.Set permanent=true to delete permanently", name)
+	}
+	for _, v := range live {
+		v.deleted = true
+		versions = append(versions, v.version)
+	}
+	return versions, nil
+}
+
+// deleteVersion soft-deletes one version, or removes it with permanent=true once soft-deleted.
+func (r *Registry) deleteVersion(req *http.Request) (any, *Error) {
+	name := req.PathValue("subject")
+	if err := r.checkWritable(name); err != nil {
+		return nil, err
+	}
+	permanent := req.URL.Query().Get("permanent") == "true"
+	_, v, err := r.findVersion(req, permanent)
+	if err != nil {
+		return nil, err
+	}
+
+	if !permanent {
+		v.deleted = true
+		return v.version, nil
+	}
+	if !v.deleted {
+		return nil, newError(http.StatusNotFound, 40407,
+			"Subject '%s' Version %d was not deleted first before being permanently deleted", name, v.version)
+	}
+	s := r.subjects[name]
+	s.versions = slices.DeleteFunc(s.versions, func(other *subjectVersion) bool { return other == v })
+	r.dropUnusedSchemas()
+	return v.version, nil
+}
+
+// dropUnusedSchemas forgets schema IDs no longer held by any version, as a hard delete does.
+func (r *Registry) dropUnusedSchemas() {
+	used := map[int]bool{}
+	for _, s := range r.subjects {
+		for _, v := range s.versions {
+			used[v.id] = true
+		}
+	}
+	for id := range r.schemas {
+		if !used[id] {
+			delete(r.schemas, id)
+		}
+	}
+}
+
+// checkCompatibility tests a schema against one version, or against the versions
+// selected by the subject compatibility level when no version is given.
+func (r *Registry) checkCompatibility(req *http.Request) (any, *Error) {
+	var request RegisterRequest
+	if err := decodeBody(req, &request); err != nil {
+		return nil, err
+	}
+	candidate, err := r.newRecord(request)
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.PathValue("subject")
+	var versions []*subjectVersion
+	transitive := req.PathValue("version") == ""
+	if transitive {
+		s, err := r.getSubject(name, false)
+		if err != nil {
+			return nil, err
+		}
+		versions = s.liveVersions(false)
+	} else {
+		_, v, err := r.findVersion(req, false)
+		if err != nil {
+			return nil, err
+		}
+		versions = []*subjectVersion{v}
+	}
+
+	compatible := r.compatibleWith(r.effectiveCompatibility(name), candidate, versions, transitive)
+	return map[string]bool{"is_compatible": compatible}, nil
+}
+
+func (r *Registry) getConfig(req *http.Request) (any, *Error) {
+	name := req.PathValue("subject")
+	if name == "" {
+		return map[string]string{"compatibilityLevel": r.compatibility}, nil
+	}
+	if s := r.subjects[name]; s != nil && s.compatibility != "" {
+		return map[string]string{"compatibilityLevel": s.compatibility}, nil
+	}
+	if req.URL.Query().Get("defaultToGlobal") == "true" {
+		return map[string]string{"compatibilityLevel": r.compatibility}, nil
+	}
+	return nil, newError(http.StatusNotFound, 40408,
+		"Subject '%s' does not have subject-level compatibility configured", name)
+}
+
+func (r *Registry) putConfig(req *http.Request) (any, *Error) {
+	var body struct {
+		Compatibility string `json:"compatibility"`
+	}
+	if err := decodeBody(req, &body); err != nil {
+		return nil, err
+	}
+	if err := r.setCompatibility(req.PathValue("subject"), body.Compatibility); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (r *Registry) deleteConfig(req *http.Request) (any, *Error) {
+	name := req.PathValue("subject")
+	s := r.subjects[name]
+	if s == nil || s.compatibility == "" {
+		return nil, newError(http.StatusNotFound, 40408,
+			"Subject '%s' does not have subject-level compatibility configured", name)
+	}
+	previous := s.compatibility
+	s.compatibility = ""
+	return map[string]string{"compatibilityLevel": previous}, nil
+}
+
+func (r *Registry) getMode(req *http.Request) (any, *Error) {
+	name := req.PathValue("subject")
+	if name == "" {
+		return map[string]string{"mode": r.mode}, nil
+	}
+	if s := r.subjects[name]; s != nil && s.mode != "" {
+		return map[string]string{"mode": s.mode}, nil
+	}
+	if req.URL.Query().Get("defaultToGlobal") == "true" {
+		return map[string]string{"mode": r.mode}, nil
+	}
+	return nil, newError(http.StatusNotFound, 40409, "Subject '%s' does not have subject-level mode configured", name)
+}
+
+func (r *Registry) putMode(req *http.Request) (any, *Error) {
+	var body struct {
+		Mode string `json:"mode"`
+	}
+	if err := decodeBody(req, &body); err != nil {
+		return nil, err
+	}
+	force := req.URL.Query().Get("force") == "true"
+	if err := r.setMode(req.PathValue("subject"), body.Mode, force); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (r *Registry) deleteMode(req *http.Request) (any, *Error) {
+	name := req.PathValue("subject")
+	s := r.subjects[name]
+	if s == nil || s.mode == "" {
+		return nil, newError(http.StatusNotFound, 40409, "Subject '%s' does not have subject-level mode configured", name)
+	}
+	previous := s.mode
+	s.mode = ""
+	return map[string]string{"mode": previous}, nil
+}
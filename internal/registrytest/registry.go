@@ -0,0 +1,569 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registrytest provides an in-memory Schema Registry implementing the
+// Confluent REST API, for tests of the client, the controllers and the CLIs.
+//
+// The registry keeps subjects, versions and schema IDs like Confluent Schema Registry
+// does: identical schemas share an ID across subjects, registration is idempotent,
+// deletes are soft until repeated with permanent=true, and compatibility and mode are
+// configurable globally and per subject. Schema exporters are stored with their state,
+// without exporting anything. AVRO schemas are checked for compatibility,
+// JSON and PROTOBUF schemas are always considered compatible.
+package registrytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Compatibility levels and modes accepted by the registry.
+const (
+	CompatibilityBackward           = "BACKWARD"
+	CompatibilityBackwardTransitive = "BACKWARD_TRANSITIVE"
+	CompatibilityForward            = "FORWARD"
+	CompatibilityForwardTransitive  = "FORWARD_TRANSITIVE"
+	CompatibilityFull               = "FULL"
+	CompatibilityFullTransitive     = "FULL_TRANSITIVE"
+	CompatibilityNone               = "NONE"
+
+	ModeReadWrite        = "READWRITE"
+	ModeReadOnly         = "READONLY"
+	ModeReadOnlyOverride = "READONLY_OVERRIDE"
+	ModeImport           = "IMPORT"
+)
+
+var (
+	compatibilityLevels = []string{
+		CompatibilityBackward, CompatibilityBackwardTransitive, CompatibilityForward,
+		CompatibilityForwardTransitive, CompatibilityFull, CompatibilityFullTransitive, CompatibilityNone,
+	}
+	modes = []string{ModeReadWrite, ModeReadOnly, ModeReadOnlyOverride, ModeImport}
+)
+
+// Error is an error response of the registry, rendered as {"error_code":...,"message":...}.
+type Error struct {
+	StatusCode int    `json:"-"`
+	ErrorCode  int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %d: %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+func newError(statusCode, errorCode int, format string, args ...any) *Error {
+	return &Error{StatusCode: statusCode, ErrorCode: errorCode, Message: fmt.Sprintf(format, args...)}
+}
+
+// asError converts err to an error, keeping a nil *Error nil instead of wrapping it
+// in a non-nil interface.
+func asError(err *Error) error {
+	if err == nil {
+		return nil
+	}
+	return err
+}
+
+// Reference is a reference from a schema to a version of another subject.
+type Reference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+// RegisterRequest is the body of POST /subjects/{subject}/versions. ID and Version
+// may only be set while the subject is in IMPORT mode.
+type RegisterRequest struct {
+	Schema     string      `json:"schema"`
+	SchemaType string      `json:"schemaType,omitempty"`
+	References []Reference `json:"references,omitempty"`
+	ID         int         `json:"id,omitempty"`
+	Version    int         `json:"version,omitempty"`
+}
+
+// Fault makes the registry misbehave for the requests it matches.
+type Fault struct {
+	// Method restricts the fault to one HTTP method, empty matches any.
+	Method string
+	// Path restricts the fault to request paths with this prefix, empty matches any.
+	Path string
+	// Latency delays the response.
+	Latency time.Duration
+	// StatusCode, when set, answers with this status and an error body
+	// instead of serving the request.
+	StatusCode int
+	// Times limits the fault to the first matching requests, zero applies it until ClearFaults.
+	Times int
+}
+
+// schemaRecord is a schema stored under an ID, shared by every subject version holding it.
+type schemaRecord struct {
+	id         int
+	schemaType string
+	schema     string
+	references []Reference
+}
+
+type subjectVersion struct {
+	version int
+	id      int
+	deleted bool
+}
+
+type subject struct {
+	versions []*subjectVersion
+	// compatibility and mode are empty when the subject inherits the global setting
+	compatibility string
+	mode          string
+}
+
+// Registry is an in-memory Schema Registry. It is safe for concurrent use.
+type Registry struct {
+	mu            sync.Mutex
+	schemas       map[int]*schemaRecord
+	subjects      map[string]*subject
+	compatibility string
+	mode          string
+	exporters     map[string]*exporter
+
+	faults   []*Fault
+	username string
+	password string
+	token    string
+	requests []string
+
+	mux *http.ServeMux
+}
+
+// New returns an empty registry with BACKWARD compatibility and READWRITE mode.
+func New() *Registry {
+	r := &Registry{
+		schemas:       map[int]*schemaRecord{},
+		subjects:      map[string]*subject{},
+		exporters:     map[string]*exporter{},
+		compatibility: CompatibilityBackward,
+		mode:          ModeReadWrite,
+	}
+	r.routes()
+	return r
+}
+
+// Server is a Registry listening on a local HTTP server.
+type Server struct {
+	*httptest.Server
+	*Registry
+}
+
+// NewServer starts a new Registry on a local HTTP server. The caller closes it when done.
+func NewServer() *Server {
+	r := New()
+	return &Server{Server: httptest.NewServer(r), Registry: r}
+}
+
+// AddFault injects a fault into the handling of matching requests.
+func (r *Registry) AddFault(f Fault) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.faults = append(r.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (r *Registry) ClearFaults() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.faults = nil
+}
+
+// RequireBasicAuth rejects requests without these basic auth credentials with 401.
+func (r *Registry) RequireBasicAuth(username, password string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.username, r.password = username, password
+}
+
+// RequireBearerToken rejects requests without this bearer token with 401.
+func (r *Registry) RequireBearerToken(token string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.token = token
+}
+
+// Requests returns the requests served so far, as "METHOD /path".
+func (r *Registry) Requests() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.requests)
+}
+
+// ResetRequests clears the request log.
+func (r *Registry) ResetRequests() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = nil
+}
+
+// Register registers a schema under subject, exactly like POST /subjects/{subject}/versions,
+// and returns its ID and version. It is meant for seeding the registry in tests.
+func (r *Registry) Register(subjectName string, request RegisterRequest) (int, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id, version, err := r.register(subjectName, request)
+	return id, version, asError(err)
+}
+
+// SetCompatibility sets the compatibility level of subject, or the global level when subject is empty.
+func (r *Registry) SetCompatibility(subjectName, level string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return asError(r.setCompatibility(subjectName, level))
+}
+
+// SetMode sets the mode of subject, or the global mode when subject is empty.
+// Unlike PUT /mode it never refuses IMPORT for a non-empty registry.
+func (r *Registry) SetMode(subjectName, mode string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return asError(r.setMode(subjectName, mode, true))
+}
+
+// Mode returns the mode of subject, or the global mode when subject is empty.
+// A subject without a mode of its own has an empty mode.
+func (r *Registry) Mode(subjectName string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if subjectName == "" {
+		return r.mode
+	}
+	if s := r.subjects[subjectName]; s != nil {
+		return s.mode
+	}
+	return ""
+}
+
+// Versions returns the versions of subject that are not deleted.
+func (r *Registry) Versions(subjectName string) []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var versions []int
+	if s := r.subjects[subjectName]; s != nil {
+		for _, v := range s.liveVersions(false) {
+			versions = append(versions, v.version)
+		}
+	}
+	return versions
+}
+
+// ServeHTTP applies authentication and injected faults and serves the Confluent REST API.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+	authorized := r.authorized(req)
+	fault := r.matchFault(req)
+	r.mu.Unlock()
+
+	if fault != nil && fault.Latency > 0 {
+		select {
+		case <-time.After(fault.Latency):
+		case <-req.Context().Done():
+			return
+		}
+	}
+	if fault != nil && fault.StatusCode != 0 {
+		writeError(w, newError(fault.StatusCode, fault.StatusCode*100+1, "Injected fault"))
+		return
+	}
+	if !authorized {
+		writeError(w, newError(http.StatusUnauthorized, http.StatusUnauthorized, "Unauthorized"))
+		return
+	}
+	r.mux.ServeHTTP(w, req)
+}
+
+func (r *Registry) authorized(req *http.Request) bool {
+	if r.username != "" {
+		username, password, ok := req.BasicAuth()
+		return ok && username == r.username && password == r.password
+	}
+	if r.token != "" {
+		return req.Header.Get("Authorization") == "Bearer "+r.token
+	}
+	return true
+}
+
+// matchFault returns the first fault matching req and counts it against its Times.
+func (r *Registry) matchFault(req *http.Request) *Fault {
+	for i, f := range r.faults {
+		if (f.Method != "" && f.Method != req.Method) || !strings.HasPrefix(req.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				r.faults = slices.Delete(r.faults, i, i+1)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// liveVersions returns the versions of the subject, including soft-deleted ones when deleted is set.
+func (s *subject) liveVersions(deleted bool) []*subjectVersion {
+	var versions []*subjectVersion
+	for _, v := range s.versions {
+		if deleted || !v.deleted {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// getSubject returns a subject that has versions, including soft-deleted ones when deleted is set.
+func (r *Registry) getSubject(name string, deleted bool) (*subject, *Error) {
+	s := r.subjects[name]
+	if s == nil || len(s.liveVersions(deleted)) == 0 {
+		return nil, newError(http.StatusNotFound, 40401, "Subject '%s' not found.", name)
+	}
+	return s, nil
+}
+
+// subjectOrNew returns the subject, creating it for configuration or a first version.
+func (r *Registry) subjectOrNew(name string) *subject {
+	s := r.subjects[name]
+	if s == nil {
+		s = &subject{}
+		r.subjects[name] = s
+	}
+	return s
+}
+
+func (r *Registry) effectiveMode(name string) string {
+	if s := r.subjects[name]; s != nil && s.mode != "" {
+		return s.mode
+	}
+	return r.mode
+}
+
+func (r *Registry) effectiveCompatibility(name string) string {
+	if s := r.subjects[name]; s != nil && s.compatibility != "" {
+		return s.compatibility
+	}
+	return r.compatibility
+}
+
+// checkWritable rejects changes to subjects in a read-only mode.
+func (r *Registry) checkWritable(name string) *Error {
+	if mode := r.effectiveMode(name); mode == ModeReadOnly || mode == ModeReadOnlyOverride {
+		return newError(http.StatusUnprocessableEntity, 42205, "Subject %s is in read-only mode", name)
+	}
+	return nil
+}
+
+// register implements POST /subjects/{subject}/versions.
+func (r *Registry) register(name string, request RegisterRequest) (int, int, *Error) {
+	if err := r.checkWritable(name); err != nil {
+		return 0, 0, err
+	}
+	importing := r.effectiveMode(name) == ModeImport
+	if (request.ID != 0 || request.Version != 0) && !importing {
+		return 0, 0, newError(http.StatusUnprocessableEntity, 42205,
+			"Subject %s is not in import mode, an ID or version cannot be set", name)
+	}
+
+	candidate, err := r.newRecord(request)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	s := r.subjectOrNew(name)
+	live := s.liveVersions(false)
+	for _, v := range live {
+		if sameSchema(r.schemas[v.id], candidate) {
+			if request.ID != 0 && request.ID != v.id {
+				return 0, 0, newError(http.StatusUnprocessableEntity, 42205,
+					"Schema already registered with id %d instead of input id %d", v.id, request.ID)
+			}
+			return v.id, v.version, nil
+		}
+	}
+
+	if !importing {
+		if compatible := r.compatibleWith(r.effectiveCompatibility(name), candidate, live, true); !compatible {
+			return 0, 0, newError(http.StatusConflict, http.StatusConflict,
+				"Schema being registered is incompatible with an earlier schema for subject %q", name)
+		}
+	}
+
+	id, err := r.assignID(candidate, request.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	version := 1
+	if n := len(s.versions); n > 0 {
+		version = s.versions[n-1].version + 1
+	}
+	if request.Version != 0 {
+		if request.Version < version {
+			return 0, 0, newError(http.StatusUnprocessableEntity, 42202,
+				"Version %d must be greater than the latest version %d of subject %s", request.Version, version-1, name)
+		}
+		version = request.Version
+	}
+
+	s.versions = append(s.versions, &subjectVersion{version: version, id: id})
+	return id, version, nil
+}
+
+// newRecord validates the request and builds the schema it registers.
+func (r *Registry) newRecord(request RegisterRequest) (*schemaRecord, *Error) {
+	record := &schemaRecord{
+		schemaType: request.SchemaType,
+		schema:     request.Schema,
+		references: request.References,
+	}
+	if record.schemaType == "" {
+		record.schemaType = "AVRO"
+	}
+	for _, ref := range record.references {
+		if _, err := r.lookupReference(ref); err != nil {
+			return nil, newError(http.StatusUnprocessableEntity, 42201,
+				"Invalid schema: reference %s to version %d of subject %s not found", ref.Name, ref.Version, ref.Subject)
+		}
+	}
+
+	switch record.schemaType {
+	case "AVRO":
+		if _, err := r.parseAvro(record); err != nil {
+			return nil, newError(http.StatusUnprocessableEntity, 42201, "Invalid schema: %v", err)
+		}
+	case "JSON":
+		if !json.Valid([]byte(record.schema)) {
+			return nil, newError(http.StatusUnprocessableEntity, 42201, "Invalid schema: not valid JSON")
+		}
+	case "PROTOBUF":
+		if strings.TrimSpace(record.schema) == "" {
+			return nil, newError(http.StatusUnprocessableEntity, 42201, "Invalid schema: empty")
+		}
+	default:
+		return nil, newError(http.StatusUnprocessableEntity, 42201, "Invalid schema type %s", record.schemaType)
+	}
+	return record, nil
+}
+
+// lookupReference returns the schema a reference points to, soft-deleted versions included.
+func (r *Registry) lookupReference(ref Reference) (*schemaRecord, error) {
+	s := r.subjects[ref.Subject]
+	if s == nil {
+		return nil, fmt.Errorf("subject %s not found", ref.Subject)
+	}
+	for _, v := range s.versions {
+		if v.version == ref.Version {
+			return r.schemas[v.id], nil
+		}
+	}
+	return nil, fmt.Errorf("version %d of subject %s not found", ref.Version, ref.Subject)
+}
+
+// assignID reuses the ID of an identical schema, or takes the requested or next free ID.
+func (r *Registry) assignID(candidate *schemaRecord, requested int) (int, *Error) {
+	for id, record := range r.schemas {
+		if sameSchema(record, candidate) {
+			if requested != 0 && requested != id {
+				return 0, newError(http.StatusUnprocessableEntity, 42205,
+					"Schema already registered with id %d instead of input id %d", id, requested)
+			}
+			return id, nil
+		}
+	}
+
+	id := requested
+	if id == 0 {
+		for existing := range r.schemas {
+			id = max(id, existing)
+		}
+		id++
+	} else if r.schemas[id] != nil {
+		return 0, newError(http.StatusUnprocessableEntity, 42205,
+			"Overwrite new schema with id %d is not permitted", id)
+	}
+	candidate.id = id
+	r.schemas[id] = candidate
+	return id, nil
+}
+
+// sameSchema compares schemas the way registration deduplicates them: by type,
+// content ignoring JSON formatting, and references.
+func sameSchema(a, b *schemaRecord) bool {
+	return a.schemaType == b.schemaType &&
+		normalize(a.schemaType, a.schema) == normalize(b.schemaType, b.schema) &&
+		slices.Equal(a.references, b.references)
+}
+
+func normalize(schemaType, schema string) string {
+	if schemaType != "PROTOBUF" {
+		var v any
+		if json.Unmarshal([]byte(schema), &v) == nil {
+			if out, err := json.Marshal(v); err == nil {
+				return string(out)
+			}
+		}
+	}
+	return strings.TrimSpace(schema)
+}
+
+func (r *Registry) setCompatibility(name, level string) *Error {
+	if !slices.Contains(compatibilityLevels, level) {
+		return newError(http.StatusUnprocessableEntity, 42203,
+			"Invalid compatibility level. Valid values are %s", strings.Join(compatibilityLevels, ", "))
+	}
+	if name == "" {
+		r.compatibility = level
+		return nil
+	}
+	r.subjectOrNew(name).compatibility = level
+	return nil
+}
+
+// setMode changes the global or subject mode. Switching to IMPORT requires the
+// registry, or the subject, to be empty unless forced.
+func (r *Registry) setMode(name, mode string, force bool) *Error {
+	if !slices.Contains(modes, mode) {
+		return newError(http.StatusUnprocessableEntity, 42204,
+			"Invalid mode. Valid values are %s", strings.Join(modes, ", "))
+	}
+	if mode == ModeImport && !force {
+		for subjectName, s := range r.subjects {
+			if (name == "" || name == subjectName) && len(s.liveVersions(false)) > 0 {
+				return newError(http.StatusUnprocessableEntity, 42205,
+					"Cannot import since found existing subjects")
+			}
+		}
+	}
+	if name == "" {
+		r.mode = mode
+		return nil
+	}
+	r.subjectOrNew(name).mode = mode
+	return nil
+}
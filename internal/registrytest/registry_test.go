@@ -0,0 +1,310 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/honza/schema-strimzi-operator/internal/client"
+	"github.com/honza/schema-strimzi-operator/internal/registrytest"
+)
+
+const (
+	orderV1 = `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`
+	orderV2 = `{"type": "record", "name": "Order", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "note", "type": ["null", "string"], "default": null}
+	]}`
+	// orderV3 adds a field without a default, which BACKWARD compatibility rejects
+	orderV3 = `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"amount","type":"long"}]}`
+)
+
+func newClient(t *testing.T, srv *registrytest.Server, auth client.AuthConfig) *client.SchemaRegistryClient {
+	t.Helper()
+	c, err := client.NewClient([]string{srv.URL}, auth, client.Options{Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func apiError(t *testing.T, err error) *client.APIError {
+	t.Helper()
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got: %v", err)
+	}
+	return apiErr
+}
+
+func TestRegistration(t *testing.T) {
+	srv := registrytest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, client.AuthConfig{Type: "NONE"})
+	ctx := context.Background()
+
+	first, err := c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if err != nil {
+		t.Fatalf("RegisterSchema: %v", err)
+	}
+	if first.ID != 1 || first.Version != 1 {
+		t.Errorf("expected ID 1 version 1, got %+v", first)
+	}
+
+	again, err := c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: " " + orderV1})
+	if err != nil || again.ID != 1 || again.Version != 1 {
+		t.Errorf("expected registration to be idempotent, got %+v, %v", again, err)
+	}
+
+	shared, err := c.RegisterSchema(ctx, "archive-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if err != nil || shared.ID != 1 || shared.Version != 1 {
+		t.Errorf("expected the ID to be shared across subjects, got %+v, %v", shared, err)
+	}
+
+	second, err := c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV2})
+	if err != nil || second.ID != 2 || second.Version != 2 {
+		t.Errorf("expected ID 2 version 2, got %+v, %v", second, err)
+	}
+
+	found, err := c.LookupSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if err != nil || found.Version != 1 || found.Subject != "orders-value" {
+		t.Errorf("unexpected lookup result %+v, %v", found, err)
+	}
+	_, err = c.LookupSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: `"string"`})
+	if !client.IsSchemaNotFound(err) {
+		t.Errorf("expected schema not found, got: %v", err)
+	}
+	_, err = c.LookupSchema(ctx, "missing-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if !client.IsSubjectNotFound(err) {
+		t.Errorf("expected subject not found, got: %v", err)
+	}
+
+	subjects, err := c.ListSubjects(ctx)
+	if err != nil || strings.Join(subjects, ",") != "archive-value,orders-value" {
+		t.Errorf("unexpected subjects %v, %v", subjects, err)
+	}
+	latest, err := c.GetSchema(ctx, "orders-value", "latest")
+	if err != nil || latest.Version != 2 || latest.SchemaType != "" {
+		t.Errorf("unexpected latest version %+v, %v", latest, err)
+	}
+
+	_, err = c.RegisterSchema(ctx, "broken-value", client.RegisterSchemaRequest{Schema: `{"type":"recrd"}`})
+	if apiErr := apiError(t, err); apiErr.StatusCode != http.StatusUnprocessableEntity || apiErr.ErrorCode != 42201 {
+		t.Errorf("expected an invalid schema error, got: %v", err)
+	}
+}
+
+func TestCompatibility(t *testing.T) {
+	srv := registrytest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, client.AuthConfig{Type: "NONE"})
+	ctx := context.Background()
+
+	if _, _, err := srv.Register("orders-value", registrytest.RegisterRequest{Schema: orderV1}); err != nil {
+		t.Fatal(err)
+	}
+
+	compatible, err := c.CheckCompatibility(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV2})
+	if err != nil || !compatible {
+		t.Errorf("expected a field with a default to be compatible, got %v, %v", compatible, err)
+	}
+	compatible, err = c.CheckCompatibility(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV3})
+	if err != nil || compatible {
+		t.Errorf("expected a field without a default to be incompatible, got %v, %v", compatible, err)
+	}
+	_, err = c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV3})
+	if !client.IsIncompatible(err) {
+		t.Errorf("expected registration to be rejected, got: %v", err)
+	}
+
+	if err := c.SetCompatibility(ctx, "orders-value", "NONE"); err != nil {
+		t.Fatal(err)
+	}
+	level, err := c.GetSubjectCompatibility(ctx, "orders-value")
+	if err != nil || level != "NONE" {
+		t.Errorf("expected NONE, got %q, %v", level, err)
+	}
+	if _, err := c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV3}); err != nil {
+		t.Errorf("expected NONE to accept any schema, got: %v", err)
+	}
+
+	err = c.SetCompatibility(ctx, "orders-value", "SIDEWAYS")
+	if apiErr := apiError(t, err); apiErr.ErrorCode != 42203 {
+		t.Errorf("expected an invalid compatibility level error, got: %v", err)
+	}
+	level, err = c.GetSubjectCompatibility(ctx, "customers-value")
+	if err != nil || level != "" {
+		t.Errorf("expected no subject level, got %q, %v", level, err)
+	}
+}
+
+func TestReferences(t *testing.T) {
+	srv := registrytest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, client.AuthConfig{Type: "NONE"})
+	ctx := context.Background()
+
+	order := client.RegisterSchemaRequest{
+		Schema:     `{"type":"record","name":"Order","fields":[{"name":"customer","type":"com.example.Customer"}]}`,
+		References: []client.SchemaReference{{Name: "com.example.Customer", Subject: "customer-value", Version: 1}},
+	}
+	_, err := c.RegisterSchema(ctx, "orders-value", order)
+	if apiErr := apiError(t, err); apiErr.ErrorCode != 42201 {
+		t.Errorf("expected a missing reference to be rejected, got: %v", err)
+	}
+
+	customer := `{"type":"record","name":"Customer","namespace":"com.example","fields":[{"name":"id","type":"string"}]}`
+	if _, _, err := srv.Register("customer-value", registrytest.RegisterRequest{Schema: customer}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.RegisterSchema(ctx, "orders-value", order); err != nil {
+		t.Fatalf("expected the reference to resolve, got: %v", err)
+	}
+	registered, err := c.GetSchema(ctx, "orders-value", "1")
+	if err != nil || len(registered.References) != 1 || registered.References[0].Subject != "customer-value" {
+		t.Errorf("unexpected references %+v, %v", registered, err)
+	}
+}
+
+func TestDeletion(t *testing.T) {
+	srv := registrytest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, client.AuthConfig{Type: "NONE"})
+	ctx := context.Background()
+
+	if _, _, err := srv.Register("orders-value", registrytest.RegisterRequest{Schema: orderV1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteSubject(ctx, "orders-value"); err != nil {
+		t.Fatalf("DeleteSubject: %v", err)
+	}
+	if versions := srv.Versions("orders-value"); len(versions) != 0 {
+		t.Errorf("expected no live versions after a soft delete, got %v", versions)
+	}
+
+	if status := deleteRequest(t, srv.URL+"/subjects/orders-value"); status != http.StatusNotFound {
+		t.Errorf("expected a repeated soft delete to fail, got %d", status)
+	}
+
+	registered, err := c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if err != nil || registered.ID != 1 || registered.Version != 2 {
+		t.Errorf("expected the version number to continue after a soft delete, got %+v, %v", registered, err)
+	}
+	if status := deleteRequest(t, srv.URL+"/subjects/orders-value?permanent=true"); status != http.StatusNotFound {
+		t.Errorf("expected a hard delete of live versions to fail, got %d", status)
+	}
+	if err := c.DeleteSubject(ctx, "orders-value"); err != nil {
+		t.Fatal(err)
+	}
+	if status := deleteRequest(t, srv.URL+"/subjects/orders-value?permanent=true"); status != http.StatusOK {
+		t.Errorf("expected the hard delete to succeed, got %d", status)
+	}
+
+	registered, err = c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if err != nil || registered.Version != 1 {
+		t.Errorf("expected a fresh subject after a hard delete, got %+v, %v", registered, err)
+	}
+}
+
+func deleteRequest(t *testing.T, url string) int {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestModes(t *testing.T) {
+	srv := registrytest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, client.AuthConfig{Type: "NONE"})
+	ctx := context.Background()
+
+	if err := srv.SetMode("orders-value", registrytest.ModeReadOnly); err != nil {
+		t.Fatal(err)
+	}
+	_, err := c.RegisterSchema(ctx, "orders-value", client.RegisterSchemaRequest{Schema: orderV1})
+	if apiErr := apiError(t, err); apiErr.ErrorCode != 42205 {
+		t.Errorf("expected a read-only subject to reject registration, got: %v", err)
+	}
+	if _, err := c.RegisterSchema(ctx, "customers-value", client.RegisterSchemaRequest{Schema: orderV1}); err != nil {
+		t.Errorf("expected other subjects to stay writable, got: %v", err)
+	}
+	if mode, err := c.GetMode(ctx); err != nil || mode != registrytest.ModeReadWrite {
+		t.Errorf("expected the global mode to be READWRITE, got %q, %v", mode, err)
+	}
+
+	if err := srv.SetMode("", registrytest.ModeImport); err != nil {
+		t.Fatal(err)
+	}
+	id, version, err := srv.Register("imported-value", registrytest.RegisterRequest{Schema: orderV3, ID: 42, Version: 7})
+	if err != nil || id != 42 || version != 7 {
+		t.Errorf("expected the imported ID and version to be kept, got %d, %d, %v", id, version, err)
+	}
+	_, _, err = srv.Register("other-value", registrytest.RegisterRequest{Schema: `"string"`, ID: 42})
+	var regErr *registrytest.Error
+	if !errors.As(err, &regErr) || regErr.ErrorCode != 42205 {
+		t.Errorf("expected an ID collision to be rejected, got: %v", err)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	srv := registrytest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, client.AuthConfig{Type: "NONE"})
+	ctx := context.Background()
+
+	srv.AddFault(registrytest.Fault{Path: "/subjects", StatusCode: http.StatusServiceUnavailable, Times: 1})
+	if err := c.HealthCheck(ctx); err == nil {
+		t.Error("expected the injected 503 to fail the health check")
+	}
+	if err := c.HealthCheck(ctx); err != nil {
+		t.Errorf("expected the fault to apply once, got: %v", err)
+	}
+
+	srv.AddFault(registrytest.Fault{Method: http.MethodGet, Latency: 2 * time.Second})
+	if err := c.HealthCheck(ctx); err == nil {
+		t.Error("expected the injected latency to exceed the client timeout")
+	}
+	srv.ClearFaults()
+
+	srv.RequireBasicAuth("operator", "secret")
+	err := c.HealthCheck(ctx)
+	if err == nil {
+		t.Error("expected a request without credentials to be rejected")
+	}
+	authorized := newClient(t, srv, client.AuthConfig{Type: "BASIC", Username: "operator", Password: "secret"})
+	if err := authorized.HealthCheck(ctx); err != nil {
+		t.Errorf("expected the credentials to be accepted, got: %v", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 5 || requests[0] != "GET /subjects" {
+		t.Errorf("unexpected request log %v", requests)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemadiff compares schema definitions for the schemactl and kubectl-schema CLIs.
+package schemadiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Diff returns the differences between the registered and the desired schema, one
+// line per change prefixed with "+", "-" or "~". JSON based schemas (AVRO and JSON)
+// are compared structurally, PROTOBUF schemas line by line.
+func Diff(schemaType, registered, desired string) []string {
+	if schemaType != "PROTOBUF" {
+		var oldValue, newValue any
+		if json.Unmarshal([]byte(registered), &oldValue) == nil && json.Unmarshal([]byte(desired), &newValue) == nil {
+			var diff []string
+			diffValues("", oldValue, newValue, &diff)
+			return diff
+		}
+	}
+	return Lines(registered, desired)
+}
+
+// diffValues appends the differences between two decoded JSON values. Arrays of
+// objects with a "name", like AVRO record fields, are matched by name.
+func diffValues(path string, oldValue, newValue any, diff *[]string) {
+	switch oldTyped := oldValue.(type) {
+	case map[string]any:
+		if newTyped, ok := newValue.(map[string]any); ok {
+			diffMaps(path, oldTyped, newTyped, diff)
+			return
+		}
+	case []any:
+		if newTyped, ok := newValue.([]any); ok {
+			oldNamed, oldOK := namedElements(oldTyped)
+			newNamed, newOK := namedElements(newTyped)
+			if oldOK && newOK {
+				diffMaps(path, oldNamed, newNamed, diff)
+				return
+			}
+			if len(oldTyped) == len(newTyped) {
+				for i := range oldTyped {
+					diffValues(fmt.Sprintf("%s[%d]", path, i), oldTyped[i], newTyped[i], diff)
+				}
+				return
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*diff = append(*diff, fmt.Sprintf("~ %s: %s -> %s", displayPath(path), compactJSON(oldValue), compactJSON(newValue)))
+	}
+}
+
+// diffMaps compares two objects key by key, in sorted order.
+func diffMaps(path string, oldMap, newMap map[string]any, diff *[]string) {
+	keys := make([]string, 0, len(oldMap)+len(newMap))
+	for key := range oldMap {
+		keys = append(keys, key)
+	}
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		child := path + "." + key
+		if strings.HasPrefix(key, "[") {
+			child = path + key
+		}
+		oldValue, inOld := oldMap[key]
+		newValue, inNew := newMap[key]
+		switch {
+		case !inOld:
+			*diff = append(*diff, fmt.Sprintf("+ %s: %s", displayPath(child), compactJSON(newValue)))
+		case !inNew:
+			*diff = append(*diff, fmt.Sprintf("- %s: %s", displayPath(child), compactJSON(oldValue)))
+		default:
+			diffValues(child, oldValue, newValue, diff)
+		}
+	}
+}
+
+// namedElements indexes an array of objects by their "name" as "[name]" keys.
+// It reports false when an element has no unique string name.
+func namedElements(values []any) (map[string]any, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+	named := make(map[string]any, len(values))
+	for _, value := range values {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := object["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		key := "[" + name + "]"
+		if _, duplicate := named[key]; duplicate {
+			return nil, false
+		}
+		named[key] = value
+	}
+	return named, true
+}
+
+func displayPath(path string) string {
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return "(schema)"
+	}
+	return path
+}
+
+func compactJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// Lines returns the removed and added lines between two texts, based on
+// their longest common subsequence. Unchanged lines are left out.
+func Lines(oldText, newText string) []string {
+	oldLines := strings.Split(strings.TrimSpace(oldText), "\n")
+	newLines := strings.Split(strings.TrimSpace(newText), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of oldLines[i:] and newLines[j:]
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if strings.TrimSpace(oldLines[i]) == strings.TrimSpace(newLines[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(oldLines) || j < len(newLines) {
+		switch {
+		case i < len(oldLines) && j < len(newLines) && strings.TrimSpace(oldLines[i]) == strings.TrimSpace(newLines[j]):
+			i++
+			j++
+		case i < len(oldLines) && (j == len(newLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			diff = append(diff, "- "+strings.TrimSpace(oldLines[i]))
+			i++
+		default:
+			diff = append(diff, "+ "+strings.TrimSpace(newLines[j]))
+			j++
+		}
+	}
+	return diff
+}
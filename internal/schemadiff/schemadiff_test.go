@@ -0,0 +1,50 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff_MatchesRecordFieldsByName(t *testing.T) {
+	registered := `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"},{"name":"legacy","type":"string"}]}`
+	desired := `{"type": "record", "name": "Order", "fields": [
+		{"name": "amount", "type": "long"},
+		{"name": "id", "type": "string"},
+		{"name": "note", "type": ["null", "string"], "default": null}
+	]}`
+
+	got := strings.Join(Diff("AVRO", registered, desired), "\n")
+	want := `~ fields[amount].type: "int" -> "long"
+- fields[legacy]: {"name":"legacy","type":"string"}
++ fields[note]: {"default":null,"name":"note","type":["null","string"]}`
+	if got != want {
+		t.Errorf("unexpected diff:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLines(t *testing.T) {
+	registered := "syntax = \"proto3\";\nmessage Event {\n  string id = 1;\n  int32 count = 2;\n}"
+	desired := "syntax = \"proto3\";\nmessage Event {\n  string id = 1;\n  int64 count = 2;\n  string source = 3;\n}"
+
+	got := strings.Join(Lines(registered, desired), "\n")
+	want := "- int32 count = 2;\n+ int64 count = 2;\n+ string source = 3;"
+	if got != want {
+		t.Errorf("unexpected diff:\n%s\nwant:\n%s", got, want)
+	}
+}
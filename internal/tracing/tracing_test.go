@@ -0,0 +1,41 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/honza/schema-strimzi-operator/internal/tracing"
+)
+
+func TestSetup_DisabledWithoutEndpoint(t *testing.T) {
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("expected no-op shutdown, got: %v", err)
+	}
+}
+
+func TestSetup_InvalidSampleRatio(t *testing.T) {
+	_, err := tracing.Setup(context.Background(), tracing.Options{Endpoint: "localhost:4317", SampleRatio: 2})
+	if err == nil {
+		t.Error("expected error for sample ratio above 1, got nil")
+	}
+}
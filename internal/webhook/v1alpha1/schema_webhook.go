@@ -51,7 +51,7 @@ type SchemaCustomValidator struct{}
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Schema.
 func (v *SchemaCustomValidator) ValidateCreate(_ context.Context, obj *registryv1alpha1.Schema) (admission.Warnings, error) {
 schemalog.Info("Validation for Schema upon creation", "name", obj.GetName())
-return nil, validateSchemaSpec(obj)
+return nil, ValidateSchemaSpec(obj)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Schema.
@@ -76,7 +76,7 @@ field.NewPath("spec", "schemaType"),
 ))
 }
 
-if err := validateSchemaSpec(newObj); err != nil {
+if err := ValidateSchemaSpec(newObj); err != nil {
 allErrs = append(allErrs, field.InternalError(field.NewPath("spec"), err))
 }
 
@@ -92,8 +92,9 @@ schemalog.Info("Validation for Schema upon deletion", "name", obj.GetName())
 return nil, nil
 }
 
-// validateSchemaSpec performs validation shared between create and update.
-func validateSchemaSpec(obj *registryv1alpha1.Schema) error {
+// ValidateSchemaSpec performs validation shared between create and update.
+// Exported for schemactl lint, which runs it on manifests before they reach a cluster.
+func ValidateSchemaSpec(obj *registryv1alpha1.Schema) error {
 var allErrs field.ErrorList
 
 if obj.Spec.Subject == "" {
@@ -103,30 +104,101 @@ field.NewPath("spec", "subject"),
 ))
 }
 
-if obj.Spec.Schema == "" {
+// Exactly one of schema and versions holds the schema content
+switch {
+case obj.Spec.Schema == "" && len(obj.Spec.Versions) == 0:
 allErrs = append(allErrs, field.Required(
 field.NewPath("spec", "schema"),
 "schema content must not be empty",
 ))
+case obj.Spec.Schema != "" && len(obj.Spec.Versions) > 0:
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "schema"),
+"schema must not be set together with versions",
+))
 }
 
-if obj.Spec.RegistryRef.Name == "" {
+if len(obj.Spec.Versions) > 0 {
+allErrs = append(allErrs, validateSchemaVersions(obj)...)
+}
+
+// Exactly one of registryRef and registryRefs selects the registries
+switch {
+case len(obj.Spec.RegistryRefs) > 0 && obj.Spec.RegistryRef != (registryv1alpha1.SchemaRegistryRef{}):
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "registryRef"),
+"registryRef must not be set together with registryRefs",
+))
+case len(obj.Spec.RegistryRefs) == 0 && obj.Spec.RegistryRef.Name == "":
 allErrs = append(allErrs, field.Required(
 field.NewPath("spec", "registryRef", "name"),
 "registryRef.name must not be empty",
 ))
 }
 
-// AVRO and JSON schemas must be valid JSON
-if (obj.Spec.SchemaType == registryv1alpha1.SchemaTypeAvro || obj.Spec.SchemaType == registryv1alpha1.SchemaTypeJSON) &&
-obj.Spec.Schema != "" {
-if !json.Valid([]byte(obj.Spec.Schema)) {
+seen := map[registryv1alpha1.SchemaRegistryRef]bool{}
+for i, ref := range obj.Spec.RegistryRefs {
+refPath := field.NewPath("spec", "registryRefs").Index(i)
+if ref.Name == "" {
+allErrs = append(allErrs, field.Required(refPath.Child("name"), "registry name must not be empty"))
+continue
+}
+// An empty namespace is the namespace of the Schema
+if ref.Namespace == obj.Namespace {
+ref.Namespace = ""
+}
+if seen[ref] {
+allErrs = append(allErrs, field.Duplicate(refPath, ref.Name))
+}
+seen[ref] = true
+}
+
+if obj.Spec.SameSchemaID && len(obj.Spec.RegistryRefs) < 2 {
 allErrs = append(allErrs, field.Invalid(
-field.NewPath("spec", "schema"),
-obj.Spec.Schema,
-fmt.Sprintf("%s schema must be valid JSON", obj.Spec.SchemaType),
+field.NewPath("spec", "sameSchemaId"),
+obj.Spec.SameSchemaID,
+"sameSchemaId requires at least two registryRefs",
 ))
 }
+
+if obj.Spec.SameSchemaID && obj.Spec.ObserveOnly {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "sameSchemaId"),
+"sameSchemaId cannot be combined with observeOnly",
+))
+}
+
+if obj.Spec.SchemaID != 0 && obj.Spec.SameSchemaID {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "schemaId"),
+"schemaId already applies to every registry and cannot be combined with sameSchemaId",
+))
+}
+
+if obj.Spec.SchemaID != 0 && obj.Spec.ObserveOnly {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "schemaId"),
+"schemaId cannot be combined with observeOnly",
+))
+}
+
+if obj.Spec.Version != 0 && obj.Spec.SchemaID == 0 {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "schemaId"),
+"version requires schemaId",
+))
+}
+
+if obj.Spec.Mode != "" && obj.Spec.ObserveOnly {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "mode"),
+"mode cannot be combined with observeOnly",
+))
+}
+
+// AVRO and JSON schemas must be valid JSON
+if obj.Spec.Schema != "" {
+allErrs = append(allErrs, validateSchemaJSON(obj.Spec.SchemaType, obj.Spec.Schema, field.NewPath("spec", "schema"))...)
 }
 
 // Validate schema references
@@ -148,3 +220,50 @@ return allErrs.ToAggregate()
 }
 return nil
 }
+
+// validateSchemaVersions validates spec.versions. The history is registered into a single
+// registry and pins its own version numbers, so the options importing or looking up one
+// schema do not apply.
+func validateSchemaVersions(obj *registryv1alpha1.Schema) field.ErrorList {
+var allErrs field.ErrorList
+versionsPath := field.NewPath("spec", "versions")
+
+if len(obj.Spec.RegistryRefs) > 0 {
+allErrs = append(allErrs, field.Forbidden(versionsPath, "versions cannot be combined with registryRefs"))
+}
+if obj.Spec.ObserveOnly {
+allErrs = append(allErrs, field.Forbidden(versionsPath, "versions cannot be combined with observeOnly"))
+}
+if obj.Spec.SchemaID != 0 || obj.Spec.Version != 0 {
+allErrs = append(allErrs, field.Forbidden(versionsPath,
+"versions cannot be combined with schemaId or version, pin the version of each entry instead"))
+}
+
+previous := 0
+for i, version := range obj.Spec.Versions {
+entryPath := versionsPath.Index(i)
+if version.Schema == "" {
+allErrs = append(allErrs, field.Required(entryPath.Child("schema"), "schema content must not be empty"))
+} else {
+allErrs = append(allErrs, validateSchemaJSON(obj.Spec.SchemaType, version.Schema, entryPath.Child("schema"))...)
+}
+// The registry numbers versions in registration order
+if version.Version != 0 {
+if version.Version <= previous {
+allErrs = append(allErrs, field.Invalid(entryPath.Child("version"), version.Version,
+fmt.Sprintf("version must be greater than the version %d pinned by an earlier entry", previous)))
+}
+previous = version.Version
+}
+}
+return allErrs
+}
+
+// validateSchemaJSON checks that an AVRO or JSON schema is valid JSON.
+func validateSchemaJSON(schemaType registryv1alpha1.SchemaType, schema string, path *field.Path) field.ErrorList {
+if (schemaType == registryv1alpha1.SchemaTypeAvro || schemaType == registryv1alpha1.SchemaTypeJSON) &&
+!json.Valid([]byte(schema)) {
+return field.ErrorList{field.Invalid(path, schema, fmt.Sprintf("%s schema must be valid JSON", schemaType))}
+}
+return nil
+}
@@ -78,6 +78,111 @@ Expect(err).To(HaveOccurred())
 Expect(err.Error()).To(ContainSubstring("registryRef"))
 })
 
+It("Should accept registryRefs with sameSchemaId", func() {
+obj := validSchema()
+obj.Spec.RegistryRef = registryv1alpha1.SchemaRegistryRef{}
+obj.Spec.RegistryRefs = []registryv1alpha1.SchemaRegistryRef{{Name: "primary"}, {Name: "dr", Namespace: "dr"}}
+obj.Spec.SameSchemaID = true
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject registryRef together with registryRefs", func() {
+obj := validSchema()
+obj.Spec.RegistryRefs = []registryv1alpha1.SchemaRegistryRef{{Name: "dr"}}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("registryRef must not be set together with registryRefs"))
+})
+
+It("Should reject duplicate registryRefs", func() {
+obj := validSchema()
+obj.Spec.RegistryRef = registryv1alpha1.SchemaRegistryRef{}
+obj.Spec.RegistryRefs = []registryv1alpha1.SchemaRegistryRef{{Name: "primary"}, {Name: "primary", Namespace: "default"}}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("spec.registryRefs[1]"))
+})
+
+It("Should reject sameSchemaId with a single registry", func() {
+obj := validSchema()
+obj.Spec.SameSchemaID = true
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("sameSchemaId"))
+})
+
+It("Should accept schemaId with version", func() {
+obj := validSchema()
+obj.Spec.SchemaID = 42
+obj.Spec.Version = 3
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject version without schemaId", func() {
+obj := validSchema()
+obj.Spec.Version = 3
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("version requires schemaId"))
+})
+
+It("Should reject schemaId with sameSchemaId", func() {
+obj := validSchema()
+obj.Spec.RegistryRef = registryv1alpha1.SchemaRegistryRef{}
+obj.Spec.RegistryRefs = []registryv1alpha1.SchemaRegistryRef{{Name: "primary"}, {Name: "dr", Namespace: "dr"}}
+obj.Spec.SameSchemaID = true
+obj.Spec.SchemaID = 42
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("cannot be combined with sameSchemaId"))
+})
+
+It("Should accept a history in versions", func() {
+obj := validSchema()
+obj.Spec.Schema = ""
+obj.Spec.Versions = []registryv1alpha1.SchemaVersion{{Schema: `"int"`, Version: 1}, {Schema: `"long"`}, {Schema: `"double"`, Version: 5}}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject schema together with versions", func() {
+obj := validSchema()
+obj.Spec.Versions = []registryv1alpha1.SchemaVersion{{Schema: `"int"`}}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("schema must not be set together with versions"))
+})
+
+It("Should reject versions pinned out of order", func() {
+obj := validSchema()
+obj.Spec.Schema = ""
+obj.Spec.Versions = []registryv1alpha1.SchemaVersion{{Schema: `"int"`, Version: 2}, {Schema: `"long"`, Version: 2}}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("spec.versions[1].version"))
+})
+
+It("Should reject an entry of versions with invalid JSON", func() {
+obj := validSchema()
+obj.Spec.Schema = ""
+obj.Spec.SchemaType = registryv1alpha1.SchemaTypeAvro
+obj.Spec.Versions = []registryv1alpha1.SchemaVersion{{Schema: `"int"`}, {Schema: `not-valid-json`}}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("spec.versions[1].schema"))
+})
+
+It("Should reject mode with observeOnly", func() {
+obj := validSchema()
+obj.Spec.Mode = registryv1alpha1.RegistryModeReadOnly
+obj.Spec.ObserveOnly = true
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("mode cannot be combined with observeOnly"))
+})
+
 It("Should reject AVRO schema with invalid JSON", func() {
 obj := validSchema()
 obj.Spec.SchemaType = registryv1alpha1.SchemaTypeAvro
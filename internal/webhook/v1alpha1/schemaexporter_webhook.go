@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+"context"
+
+"k8s.io/apimachinery/pkg/util/validation/field"
+ctrl "sigs.k8s.io/controller-runtime"
+logf "sigs.k8s.io/controller-runtime/pkg/log"
+"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+// nolint:unused
+var schemaexporterlog = logf.Log.WithName("schemaexporter-resource")
+
+// SetupSchemaExporterWebhookWithManager registers the webhook for SchemaExporter in the manager.
+func SetupSchemaExporterWebhookWithManager(mgr ctrl.Manager) error {
+return ctrl.NewWebhookManagedBy(mgr, &registryv1alpha1.SchemaExporter{}).
+WithValidator(&SchemaExporterCustomValidator{}).
+Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-registry-strimzi-io-v1alpha1-schemaexporter,mutating=false,failurePolicy=fail,sideEffects=None,groups=registry.strimzi.io,resources=schemaexporters,verbs=create;update,versions=v1alpha1,name=vschemaexporter-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SchemaExporterCustomValidator validates SchemaExporter resources on create and update.
+type SchemaExporterCustomValidator struct{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type SchemaExporter.
+func (v *SchemaExporterCustomValidator) ValidateCreate(_ context.Context, obj *registryv1alpha1.SchemaExporter) (admission.Warnings, error) {
+schemaexporterlog.Info("Validation for SchemaExporter upon creation", "name", obj.GetName())
+return nil, ValidateSchemaExporterSpec(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type SchemaExporter.
+func (v *SchemaExporterCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj *registryv1alpha1.SchemaExporter) (admission.Warnings, error) {
+schemaexporterlog.Info("Validation for SchemaExporter upon update", "name", newObj.GetName())
+
+var allErrs field.ErrorList
+
+// The exporter is identified by its name in the registry running it, changing either
+// would leave the old exporter behind
+if oldObj.Spec.ExporterName != newObj.Spec.ExporterName {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "exporterName"),
+"exporterName is immutable and cannot be changed after creation",
+))
+}
+if oldObj.Spec.RegistryRef != newObj.Spec.RegistryRef {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "registryRef"),
+"registryRef is immutable and cannot be changed after creation",
+))
+}
+
+if err := ValidateSchemaExporterSpec(newObj); err != nil {
+allErrs = append(allErrs, field.InternalError(field.NewPath("spec"), err))
+}
+
+if len(allErrs) > 0 {
+return nil, allErrs.ToAggregate()
+}
+return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type SchemaExporter.
+func (v *SchemaExporterCustomValidator) ValidateDelete(_ context.Context, obj *registryv1alpha1.SchemaExporter) (admission.Warnings, error) {
+schemaexporterlog.Info("Validation for SchemaExporter upon deletion", "name", obj.GetName())
+return nil, nil
+}
+
+// ValidateSchemaExporterSpec performs validation shared between create and update.
+func ValidateSchemaExporterSpec(obj *registryv1alpha1.SchemaExporter) error {
+var allErrs field.ErrorList
+
+if obj.Spec.RegistryRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "registryRef", "name"),
+"registryRef.name must not be empty",
+))
+}
+
+if obj.Spec.DestinationRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "destinationRef", "name"),
+"destinationRef.name must not be empty",
+))
+}
+
+// An empty namespace is the namespace of the SchemaExporter
+source, destination := obj.Spec.RegistryRef, obj.Spec.DestinationRef
+if source.Namespace == "" {
+source.Namespace = obj.Namespace
+}
+if destination.Namespace == "" {
+destination.Namespace = obj.Namespace
+}
+if source.Name != "" && source == destination {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "destinationRef"),
+obj.Spec.DestinationRef.Name,
+"destinationRef must reference another registry than registryRef",
+))
+}
+
+// context names the destination context of the CUSTOM context type only
+switch {
+case obj.Spec.ContextType == registryv1alpha1.ExporterContextCustom && obj.Spec.Context == "":
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "context"),
+"context is required when contextType is CUSTOM",
+))
+case obj.Spec.ContextType != registryv1alpha1.ExporterContextCustom && obj.Spec.Context != "":
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "context"),
+"context is only allowed when contextType is CUSTOM",
+))
+}
+
+for i, subject := range obj.Spec.Subjects {
+if subject == "" {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "subjects").Index(i),
+subject,
+"subject must not be empty",
+))
+}
+}
+
+if len(allErrs) > 0 {
+return allErrs.ToAggregate()
+}
+return nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+. "github.com/onsi/ginkgo/v2"
+. "github.com/onsi/gomega"
+
+metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+func validSchemaExporter() *registryv1alpha1.SchemaExporter {
+return &registryv1alpha1.SchemaExporter{
+ObjectMeta: metav1.ObjectMeta{Name: "prod-to-dr", Namespace: "default"},
+Spec: registryv1alpha1.SchemaExporterSpec{
+RegistryRef:    registryv1alpha1.SchemaRegistryRef{Name: "prod"},
+DestinationRef: registryv1alpha1.SchemaRegistryRef{Name: "dr"},
+ContextType:    registryv1alpha1.ExporterContextAuto,
+Subjects:       []string{"orders-value"},
+},
+}
+}
+
+var _ = Describe("SchemaExporter Webhook", func() {
+var validator SchemaExporterCustomValidator
+
+BeforeEach(func() {
+validator = SchemaExporterCustomValidator{}
+})
+
+Context("ValidateCreate", func() {
+It("Should accept a valid SchemaExporter", func() {
+_, err := validator.ValidateCreate(ctx, validSchemaExporter())
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject when destinationRef.name is empty", func() {
+obj := validSchemaExporter()
+obj.Spec.DestinationRef.Name = ""
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("destinationRef"))
+})
+
+It("Should reject exporting to the registry running the exporter", func() {
+obj := validSchemaExporter()
+obj.Spec.DestinationRef = registryv1alpha1.SchemaRegistryRef{Name: "prod", Namespace: "default"}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("another registry"))
+})
+
+It("Should require context with the CUSTOM context type", func() {
+obj := validSchemaExporter()
+obj.Spec.ContextType = registryv1alpha1.ExporterContextCustom
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("context is required"))
+
+obj.Spec.Context = "prod"
+_, err = validator.ValidateCreate(ctx, obj)
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject context with other context types", func() {
+obj := validSchemaExporter()
+obj.Spec.Context = "prod"
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("only allowed when contextType is CUSTOM"))
+})
+})
+
+Context("ValidateUpdate", func() {
+It("Should reject changing exporterName", func() {
+oldObj := validSchemaExporter()
+newObj := validSchemaExporter()
+newObj.Spec.ExporterName = "renamed"
+_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("exporterName is immutable"))
+})
+
+It("Should allow changing the destination and subjects", func() {
+oldObj := validSchemaExporter()
+newObj := validSchemaExporter()
+newObj.Spec.DestinationRef.Name = "dr2"
+newObj.Spec.Subjects = []string{"*"}
+_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+Expect(err).NotTo(HaveOccurred())
+})
+})
+
+Context("ValidateDelete", func() {
+It("Should always allow deletion", func() {
+_, err := validator.ValidateDelete(ctx, validSchemaExporter())
+Expect(err).NotTo(HaveOccurred())
+})
+})
+})
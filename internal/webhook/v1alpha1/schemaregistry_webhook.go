@@ -18,13 +18,19 @@ package v1alpha1
 
 import (
 "context"
+"crypto/tls"
+"net/http"
+"net/url"
 
+"golang.org/x/net/http/httpguts"
+"k8s.io/apimachinery/pkg/util/validation"
 "k8s.io/apimachinery/pkg/util/validation/field"
 ctrl "sigs.k8s.io/controller-runtime"
 logf "sigs.k8s.io/controller-runtime/pkg/log"
 "sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+schemaclient "github.com/honza/schema-strimzi-operator/internal/client"
 )
 
 // nolint:unused
@@ -49,13 +55,13 @@ type SchemaRegistryCustomValidator struct{}
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type SchemaRegistry.
 func (v *SchemaRegistryCustomValidator) ValidateCreate(_ context.Context, obj *registryv1alpha1.SchemaRegistry) (admission.Warnings, error) {
 schemaregistrylog.Info("Validation for SchemaRegistry upon creation", "name", obj.GetName())
-return nil, validateSchemaRegistrySpec(obj)
+return nil, ValidateSchemaRegistrySpec(obj)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type SchemaRegistry.
 func (v *SchemaRegistryCustomValidator) ValidateUpdate(_ context.Context, _, newObj *registryv1alpha1.SchemaRegistry) (admission.Warnings, error) {
 schemaregistrylog.Info("Validation for SchemaRegistry upon update", "name", newObj.GetName())
-return nil, validateSchemaRegistrySpec(newObj)
+return nil, ValidateSchemaRegistrySpec(newObj)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type SchemaRegistry.
@@ -64,14 +70,78 @@ schemaregistrylog.Info("Validation for SchemaRegistry upon deletion", "name", ob
 return nil, nil
 }
 
-// validateSchemaRegistrySpec performs validation shared between create and update.
-func validateSchemaRegistrySpec(obj *registryv1alpha1.SchemaRegistry) error {
+// ValidateSchemaRegistrySpec performs validation shared between create and update.
+// schemactl lint runs it on SchemaRegistry manifests offline.
+func ValidateSchemaRegistrySpec(obj *registryv1alpha1.SchemaRegistry) error {
 var allErrs field.ErrorList
 
-if obj.Spec.URL == "" {
+glue := obj.Spec.Flavor == registryv1alpha1.RegistryFlavorGlue
+
+switch {
+case obj.Spec.URL == "" && len(obj.Spec.URLs) == 0 && !glue:
 allErrs = append(allErrs, field.Required(
 field.NewPath("spec", "url"),
-"url must not be empty",
+"url or urls must not be empty",
+))
+case obj.Spec.URL != "" && len(obj.Spec.URLs) > 0:
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "urls"),
+obj.Spec.URLs,
+"only one of url or urls may be set",
+))
+}
+
+seenURLs := make(map[string]bool)
+for i, u := range obj.Spec.URLs {
+urlPath := field.NewPath("spec", "urls").Index(i)
+parsed, err := url.Parse(u)
+if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+allErrs = append(allErrs, field.Invalid(urlPath, u, "must be an absolute http or https URL"))
+continue
+}
+if seenURLs[u] {
+allErrs = append(allErrs, field.Duplicate(urlPath, u))
+}
+seenURLs[u] = true
+}
+
+if obj.Spec.Apicurio != nil &&
+obj.Spec.Flavor != registryv1alpha1.RegistryFlavorApicurioV2 && obj.Spec.Flavor != registryv1alpha1.RegistryFlavorApicurioV3 {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "apicurio"),
+obj.Spec.Apicurio,
+"apicurio may only be set with flavor ApicurioV2 or ApicurioV3",
+))
+}
+
+if obj.Spec.Mode != "" && obj.Spec.Flavor != "" && obj.Spec.Flavor != registryv1alpha1.RegistryFlavorConfluent {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "mode"),
+obj.Spec.Mode,
+"mode is only supported with flavor Confluent",
+))
+}
+
+switch {
+case glue && obj.Spec.Glue == nil:
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "glue"),
+"glue must be set with flavor Glue",
+))
+case !glue && obj.Spec.Glue != nil:
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "glue"),
+obj.Spec.Glue,
+"glue may only be set with flavor Glue",
+))
+}
+
+// Glue requests are signed with IAM credentials from spec.glue
+if glue && obj.Spec.Auth != nil && obj.Spec.Auth.Type != registryv1alpha1.AuthTypeNone {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "auth", "type"),
+obj.Spec.Auth.Type,
+"auth is not supported with flavor Glue, use glue.credentialsSecretRef",
 ))
 }
 
@@ -83,7 +153,35 @@ obj.Spec.Timeout,
 ))
 }
 
-if obj.Spec.Auth != nil {
+if obj.Spec.HealthCheckInterval < 0 {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "healthCheckInterval"),
+obj.Spec.HealthCheckInterval,
+"healthCheckInterval must be >= 0",
+))
+}
+
+if obj.Spec.Auth != nil && obj.Spec.Auth.KafkaUserRef != nil {
+authPath := field.NewPath("spec", "auth")
+
+// KafkaUser credentials replace basicAuth/mtls for the types Strimzi supports
+switch obj.Spec.Auth.Type {
+case registryv1alpha1.AuthTypeBasic, registryv1alpha1.AuthTypeMTLS:
+default:
+allErrs = append(allErrs, field.Invalid(
+authPath.Child("type"),
+obj.Spec.Auth.Type,
+"kafkaUserRef is only supported with auth type BASIC or MTLS",
+))
+}
+
+if obj.Spec.Auth.KafkaUserRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+authPath.Child("kafkaUserRef", "name"),
+"kafkaUserRef.name must not be empty",
+))
+}
+} else if obj.Spec.Auth != nil {
 authPath := field.NewPath("spec", "auth")
 
 switch obj.Spec.Auth.Type {
@@ -91,14 +189,20 @@ case registryv1alpha1.AuthTypeBasic:
 if obj.Spec.Auth.BasicAuth == nil {
 allErrs = append(allErrs, field.Required(
 authPath.Child("basicAuth"),
-"basicAuth must be set when auth type is BASIC",
+"basicAuth or kafkaUserRef must be set when auth type is BASIC",
 ))
-} else if obj.Spec.Auth.BasicAuth.SecretRef == "" {
+} else {
+ref := obj.Spec.Auth.BasicAuth.SecretRef
+refPath := authPath.Child("basicAuth", "secretRef")
+if ref.Name == "" {
 allErrs = append(allErrs, field.Required(
-authPath.Child("basicAuth", "secretRef"),
-"basicAuth.secretRef must not be empty",
+refPath.Child("name"),
+"basicAuth.secretRef.name must not be empty",
 ))
 }
+allErrs = append(allErrs, validateSecretKey(refPath.Child("usernameKey"), ref.UsernameKey)...)
+allErrs = append(allErrs, validateSecretKey(refPath.Child("passwordKey"), ref.PasswordKey)...)
+}
 
 case registryv1alpha1.AuthTypeBearer:
 if obj.Spec.Auth.BearerAuth == nil {
@@ -106,30 +210,221 @@ allErrs = append(allErrs, field.Required(
 authPath.Child("bearerAuth"),
 "bearerAuth must be set when auth type is BEARER",
 ))
-} else if obj.Spec.Auth.BearerAuth.SecretRef == "" {
+} else {
+ref := obj.Spec.Auth.BearerAuth.SecretRef
+refPath := authPath.Child("bearerAuth", "secretRef")
+if ref.Name == "" {
 allErrs = append(allErrs, field.Required(
-authPath.Child("bearerAuth", "secretRef"),
-"bearerAuth.secretRef must not be empty",
+refPath.Child("name"),
+"bearerAuth.secretRef.name must not be empty",
 ))
 }
+allErrs = append(allErrs, validateSecretKey(refPath.Child("tokenKey"), ref.TokenKey)...)
+}
 
 case registryv1alpha1.AuthTypeMTLS:
 if obj.Spec.Auth.MTLS == nil {
 allErrs = append(allErrs, field.Required(
 authPath.Child("mtls"),
-"mtls must be set when auth type is MTLS",
+"mtls or kafkaUserRef must be set when auth type is MTLS",
+))
+} else {
+ref := obj.Spec.Auth.MTLS.CertSecretRef
+refPath := authPath.Child("mtls", "certSecretRef")
+if ref.Name == "" {
+allErrs = append(allErrs, field.Required(
+refPath.Child("name"),
+"mtls.certSecretRef.name must not be empty",
 ))
-} else if obj.Spec.Auth.MTLS.CertSecretRef == "" {
+}
+allErrs = append(allErrs, validateSecretKey(refPath.Child("certKey"), ref.CertKey)...)
+allErrs = append(allErrs, validateSecretKey(refPath.Child("keyKey"), ref.KeyKey)...)
+
+if caRef := obj.Spec.Auth.MTLS.CASecretRef; caRef != nil {
+caPath := authPath.Child("mtls", "caSecretRef")
+if caRef.Name == "" {
 allErrs = append(allErrs, field.Required(
-authPath.Child("mtls", "certSecretRef"),
-"mtls.certSecretRef must not be empty",
+caPath.Child("name"),
+"mtls.caSecretRef.name must not be empty",
 ))
 }
+allErrs = append(allErrs, validateSecretKey(caPath.Child("key"), caRef.Key)...)
+}
+}
+}
+}
+
+if obj.Spec.TLS != nil {
+allErrs = append(allErrs, validateTLSConfig(field.NewPath("spec", "tls"), obj.Spec.TLS)...)
 }
+
+if obj.Spec.Proxy != nil {
+allErrs = append(allErrs, validateProxyConfig(field.NewPath("spec", "proxy"), obj.Spec.Proxy)...)
 }
 
+allErrs = append(allErrs, validateHeaders(field.NewPath("spec", "headers"), obj.Spec.Headers)...)
+
 if len(allErrs) > 0 {
 return allErrs.ToAggregate()
 }
 return nil
 }
+
+// validateTLSConfig checks the CA bundle source and the cipher suite names of spec.tls.
+func validateTLSConfig(path *field.Path, tlsConfig *registryv1alpha1.TLSConfig) field.ErrorList {
+var allErrs field.ErrorList
+
+if ca := tlsConfig.CA; ca != nil {
+caPath := path.Child("ca")
+switch {
+case ca.SecretRef != nil && ca.ConfigMapRef != nil:
+allErrs = append(allErrs, field.Invalid(
+caPath,
+"secretRef, configMapRef",
+"only one of tls.ca.secretRef or tls.ca.configMapRef may be set",
+))
+case ca.SecretRef != nil:
+if ca.SecretRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+caPath.Child("secretRef", "name"),
+"tls.ca.secretRef.name must not be empty",
+))
+}
+allErrs = append(allErrs, validateSecretKey(caPath.Child("secretRef", "key"), ca.SecretRef.Key)...)
+case ca.ConfigMapRef != nil:
+if ca.ConfigMapRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+caPath.Child("configMapRef", "name"),
+"tls.ca.configMapRef.name must not be empty",
+))
+}
+allErrs = append(allErrs, validateSecretKey(caPath.Child("configMapRef", "key"), ca.ConfigMapRef.Key)...)
+default:
+allErrs = append(allErrs, field.Required(
+caPath,
+"one of tls.ca.secretRef or tls.ca.configMapRef must be set",
+))
+}
+}
+
+for i, name := range tlsConfig.CipherSuites {
+if _, err := schemaclient.ParseCipherSuites([]string{name}); err != nil {
+allErrs = append(allErrs, field.NotSupported(
+path.Child("cipherSuites").Index(i),
+name,
+supportedCipherSuites(),
+))
+}
+}
+
+if tlsConfig.MinVersion == registryv1alpha1.TLSVersion13 && len(tlsConfig.CipherSuites) > 0 {
+allErrs = append(allErrs, field.Invalid(
+path.Child("cipherSuites"),
+tlsConfig.CipherSuites,
+"cipherSuites only apply to TLS 1.2 and cannot be set when minVersion is TLSv1.3",
+))
+}
+
+return allErrs
+}
+
+// validateProxyConfig checks the proxy URL and the optional proxy credentials Secret.
+func validateProxyConfig(path *field.Path, proxy *registryv1alpha1.ProxyConfig) field.ErrorList {
+var allErrs field.ErrorList
+
+proxyURL, err := url.Parse(proxy.URL)
+switch {
+case err != nil:
+allErrs = append(allErrs, field.Invalid(path.Child("url"), proxy.URL, err.Error()))
+case proxyURL.Scheme != "http" && proxyURL.Scheme != "https":
+allErrs = append(allErrs, field.Invalid(path.Child("url"), proxy.URL, "proxy url must use the http or https scheme"))
+case proxyURL.Host == "":
+allErrs = append(allErrs, field.Invalid(path.Child("url"), proxy.URL, "proxy url must include a host"))
+case proxyURL.User != nil:
+allErrs = append(allErrs, field.Invalid(path.Child("url"), "<redacted>", "proxy credentials must be set through proxy.authSecretRef"))
+}
+
+if ref := proxy.AuthSecretRef; ref != nil {
+refPath := path.Child("authSecretRef")
+if ref.Name == "" {
+allErrs = append(allErrs, field.Required(
+refPath.Child("name"),
+"proxy.authSecretRef.name must not be empty",
+))
+}
+allErrs = append(allErrs, validateSecretKey(refPath.Child("usernameKey"), ref.UsernameKey)...)
+allErrs = append(allErrs, validateSecretKey(refPath.Child("passwordKey"), ref.PasswordKey)...)
+}
+
+return allErrs
+}
+
+// validateHeaders checks the names and value sources of the extra request headers.
+func validateHeaders(path *field.Path, headers []registryv1alpha1.HTTPHeader) field.ErrorList {
+var allErrs field.ErrorList
+
+seen := make(map[string]bool)
+for i, header := range headers {
+headerPath := path.Index(i)
+
+if !httpguts.ValidHeaderFieldName(header.Name) {
+allErrs = append(allErrs, field.Invalid(headerPath.Child("name"), header.Name, "must be a valid HTTP header name"))
+} else {
+canonical := http.CanonicalHeaderKey(header.Name)
+if seen[canonical] {
+allErrs = append(allErrs, field.Duplicate(headerPath.Child("name"), header.Name))
+}
+seen[canonical] = true
+}
+
+switch {
+case header.ValueFrom != nil && header.Value != "":
+allErrs = append(allErrs, field.Invalid(
+headerPath,
+header.Name,
+"only one of value or valueFrom may be set",
+))
+case header.ValueFrom != nil:
+ref := header.ValueFrom.SecretKeyRef
+refPath := headerPath.Child("valueFrom", "secretKeyRef")
+if ref.Name == "" {
+allErrs = append(allErrs, field.Required(refPath.Child("name"), "secretKeyRef.name must not be empty"))
+}
+if ref.Key == "" {
+allErrs = append(allErrs, field.Required(refPath.Child("key"), "secretKeyRef.key must not be empty"))
+}
+allErrs = append(allErrs, validateSecretKey(refPath.Child("key"), ref.Key)...)
+case header.Value == "":
+allErrs = append(allErrs, field.Required(
+headerPath.Child("value"),
+"one of value or valueFrom must be set",
+))
+case !httpguts.ValidHeaderFieldValue(header.Value):
+allErrs = append(allErrs, field.Invalid(headerPath.Child("value"), header.Value, "must be a valid HTTP header value"))
+}
+}
+
+return allErrs
+}
+
+// supportedCipherSuites lists the cipher suite names accepted in spec.tls.cipherSuites.
+func supportedCipherSuites() []string {
+var names []string
+for _, suite := range tls.CipherSuites() {
+names = append(names, suite.Name)
+}
+return names
+}
+
+// validateSecretKey checks that a Secret key override is a valid Secret data key.
+// An empty key is accepted and resolves to the default key name.
+func validateSecretKey(path *field.Path, key string) field.ErrorList {
+var allErrs field.ErrorList
+if key == "" {
+return allErrs
+}
+for _, msg := range validation.IsConfigMapKey(key) {
+allErrs = append(allErrs, field.Invalid(path, key, msg))
+}
+return allErrs
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+"context"
+"regexp"
+
+"k8s.io/apimachinery/pkg/util/validation/field"
+ctrl "sigs.k8s.io/controller-runtime"
+logf "sigs.k8s.io/controller-runtime/pkg/log"
+"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+// nolint:unused
+var schemareplicationlog = logf.Log.WithName("schemareplication-resource")
+
+// SetupSchemaReplicationWebhookWithManager registers the webhook for SchemaReplication in the manager.
+func SetupSchemaReplicationWebhookWithManager(mgr ctrl.Manager) error {
+return ctrl.NewWebhookManagedBy(mgr, &registryv1alpha1.SchemaReplication{}).
+WithValidator(&SchemaReplicationCustomValidator{}).
+Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-registry-strimzi-io-v1alpha1-schemareplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=registry.strimzi.io,resources=schemareplications,verbs=create;update,versions=v1alpha1,name=vschemareplication-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// SchemaReplicationCustomValidator validates SchemaReplication resources on create and update.
+type SchemaReplicationCustomValidator struct{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type SchemaReplication.
+func (v *SchemaReplicationCustomValidator) ValidateCreate(_ context.Context, obj *registryv1alpha1.SchemaReplication) (admission.Warnings, error) {
+schemareplicationlog.Info("Validation for SchemaReplication upon creation", "name", obj.GetName())
+return nil, ValidateSchemaReplicationSpec(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type SchemaReplication.
+func (v *SchemaReplicationCustomValidator) ValidateUpdate(_ context.Context, _, newObj *registryv1alpha1.SchemaReplication) (admission.Warnings, error) {
+schemareplicationlog.Info("Validation for SchemaReplication upon update", "name", newObj.GetName())
+return nil, ValidateSchemaReplicationSpec(newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type SchemaReplication.
+func (v *SchemaReplicationCustomValidator) ValidateDelete(_ context.Context, obj *registryv1alpha1.SchemaReplication) (admission.Warnings, error) {
+schemareplicationlog.Info("Validation for SchemaReplication upon deletion", "name", obj.GetName())
+return nil, nil
+}
+
+// ValidateSchemaReplicationSpec performs validation shared between create and update.
+func ValidateSchemaReplicationSpec(obj *registryv1alpha1.SchemaReplication) error {
+var allErrs field.ErrorList
+
+if obj.Spec.SourceRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "sourceRef", "name"),
+"sourceRef.name must not be empty",
+))
+}
+
+if obj.Spec.TargetRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "targetRef", "name"),
+"targetRef.name must not be empty",
+))
+}
+
+// An empty namespace is the namespace of the SchemaReplication
+source, target := obj.Spec.SourceRef, obj.Spec.TargetRef
+if source.Namespace == "" {
+source.Namespace = obj.Namespace
+}
+if target.Namespace == "" {
+target.Namespace = obj.Namespace
+}
+if source.Name != "" && source == target {
+allErrs = append(allErrs, field.Invalid(
+field.NewPath("spec", "targetRef"),
+obj.Spec.TargetRef.Name,
+"targetRef must reference another registry than sourceRef",
+))
+}
+
+allErrs = append(allErrs, validatePatterns(field.NewPath("spec", "subjects", "include"), obj.Spec.Subjects.Include)...)
+allErrs = append(allErrs, validatePatterns(field.NewPath("spec", "subjects", "exclude"), obj.Spec.Subjects.Exclude)...)
+
+if len(allErrs) > 0 {
+return allErrs.ToAggregate()
+}
+return nil
+}
+
+// validatePatterns checks that every subject filter pattern is a valid regular expression.
+func validatePatterns(path *field.Path, patterns []string) field.ErrorList {
+var allErrs field.ErrorList
+for i, pattern := range patterns {
+if _, err := regexp.Compile(pattern); err != nil {
+allErrs = append(allErrs, field.Invalid(path.Index(i), pattern, err.Error()))
+}
+}
+return allErrs
+}
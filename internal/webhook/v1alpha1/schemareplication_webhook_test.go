@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+. "github.com/onsi/ginkgo/v2"
+. "github.com/onsi/gomega"
+
+metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+func validSchemaReplication() *registryv1alpha1.SchemaReplication {
+return &registryv1alpha1.SchemaReplication{
+ObjectMeta: metav1.ObjectMeta{Name: "prod-to-staging", Namespace: "default"},
+Spec: registryv1alpha1.SchemaReplicationSpec{
+SourceRef: registryv1alpha1.SchemaRegistryRef{Name: "prod"},
+TargetRef: registryv1alpha1.SchemaRegistryRef{Name: "staging"},
+Subjects: registryv1alpha1.SubjectFilter{
+Include: []string{`^orders-`},
+Exclude: []string{`-test$`},
+},
+},
+}
+}
+
+var _ = Describe("SchemaReplication Webhook", func() {
+var validator SchemaReplicationCustomValidator
+
+BeforeEach(func() {
+validator = SchemaReplicationCustomValidator{}
+})
+
+Context("ValidateCreate", func() {
+It("Should accept a valid SchemaReplication", func() {
+_, err := validator.ValidateCreate(ctx, validSchemaReplication())
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject when targetRef.name is empty", func() {
+obj := validSchemaReplication()
+obj.Spec.TargetRef.Name = ""
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("targetRef"))
+})
+
+It("Should reject the same source and target registry", func() {
+obj := validSchemaReplication()
+obj.Spec.TargetRef = registryv1alpha1.SchemaRegistryRef{Name: "prod", Namespace: "default"}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("another registry"))
+})
+
+It("Should accept registries with the same name in different namespaces", func() {
+obj := validSchemaReplication()
+obj.Spec.TargetRef = registryv1alpha1.SchemaRegistryRef{Name: "prod", Namespace: "staging"}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject an invalid subject pattern", func() {
+obj := validSchemaReplication()
+obj.Spec.Subjects.Exclude = []string{"orders-("}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("spec.subjects.exclude[0]"))
+})
+})
+
+Context("ValidateDelete", func() {
+It("Should always allow deletion", func() {
+_, err := validator.ValidateDelete(ctx, validSchemaReplication())
+Expect(err).NotTo(HaveOccurred())
+})
+})
+})
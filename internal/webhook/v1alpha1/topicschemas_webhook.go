@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+"context"
+"encoding/json"
+"fmt"
+
+"k8s.io/apimachinery/pkg/util/validation/field"
+ctrl "sigs.k8s.io/controller-runtime"
+logf "sigs.k8s.io/controller-runtime/pkg/log"
+"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+// nolint:unused
+var topicschemaslog = logf.Log.WithName("topicschemas-resource")
+
+// SetupTopicSchemasWebhookWithManager registers the webhook for TopicSchemas in the manager.
+func SetupTopicSchemasWebhookWithManager(mgr ctrl.Manager) error {
+return ctrl.NewWebhookManagedBy(mgr, &registryv1alpha1.TopicSchemas{}).
+WithValidator(&TopicSchemasCustomValidator{}).
+Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-registry-strimzi-io-v1alpha1-topicschemas,mutating=false,failurePolicy=fail,sideEffects=None,groups=registry.strimzi.io,resources=topicschemas,verbs=create;update,versions=v1alpha1,name=vtopicschemas-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// TopicSchemasCustomValidator validates TopicSchemas resources on create and update.
+type TopicSchemasCustomValidator struct{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type TopicSchemas.
+func (v *TopicSchemasCustomValidator) ValidateCreate(_ context.Context, obj *registryv1alpha1.TopicSchemas) (admission.Warnings, error) {
+topicschemaslog.Info("Validation for TopicSchemas upon creation", "name", obj.GetName())
+return nil, ValidateTopicSchemasSpec(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type TopicSchemas.
+func (v *TopicSchemasCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj *registryv1alpha1.TopicSchemas) (admission.Warnings, error) {
+topicschemaslog.Info("Validation for TopicSchemas upon update", "name", newObj.GetName())
+
+var allErrs field.ErrorList
+
+// topic is immutable after creation, since it determines both subject names
+if oldObj.Spec.Topic != newObj.Spec.Topic {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "topic"),
+"topic is immutable and cannot be changed after creation",
+))
+}
+
+// schemaType of an existing key or value is immutable
+if oldObj.Spec.Key != nil && newObj.Spec.Key != nil && oldObj.Spec.Key.SchemaType != newObj.Spec.Key.SchemaType {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "key", "schemaType"),
+"schemaType is immutable and cannot be changed after creation",
+))
+}
+if oldObj.Spec.Value != nil && newObj.Spec.Value != nil && oldObj.Spec.Value.SchemaType != newObj.Spec.Value.SchemaType {
+allErrs = append(allErrs, field.Forbidden(
+field.NewPath("spec", "value", "schemaType"),
+"schemaType is immutable and cannot be changed after creation",
+))
+}
+
+if err := ValidateTopicSchemasSpec(newObj); err != nil {
+allErrs = append(allErrs, field.InternalError(field.NewPath("spec"), err))
+}
+
+if len(allErrs) > 0 {
+return nil, allErrs.ToAggregate()
+}
+return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type TopicSchemas.
+func (v *TopicSchemasCustomValidator) ValidateDelete(_ context.Context, obj *registryv1alpha1.TopicSchemas) (admission.Warnings, error) {
+topicschemaslog.Info("Validation for TopicSchemas upon deletion", "name", obj.GetName())
+return nil, nil
+}
+
+// ValidateTopicSchemasSpec performs validation shared between create and update.
+// Also called by schemactl lint.
+func ValidateTopicSchemasSpec(obj *registryv1alpha1.TopicSchemas) error {
+var allErrs field.ErrorList
+
+if obj.Spec.Topic == "" {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "topic"),
+"topic must not be empty",
+))
+}
+
+if obj.Spec.RegistryRef.Name == "" {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec", "registryRef", "name"),
+"registryRef.name must not be empty",
+))
+}
+
+if obj.Spec.Key == nil && obj.Spec.Value == nil {
+allErrs = append(allErrs, field.Required(
+field.NewPath("spec"),
+"at least one of key or value must be set",
+))
+}
+
+if obj.Spec.Key != nil {
+allErrs = append(allErrs, validateTopicSchemaDefinition(field.NewPath("spec", "key"), obj.Spec.Key)...)
+}
+if obj.Spec.Value != nil {
+allErrs = append(allErrs, validateTopicSchemaDefinition(field.NewPath("spec", "value"), obj.Spec.Value)...)
+}
+
+if len(allErrs) > 0 {
+return allErrs.ToAggregate()
+}
+return nil
+}
+
+// validateTopicSchemaDefinition validates the key or value schema of a TopicSchemas resource.
+func validateTopicSchemaDefinition(path *field.Path, def *registryv1alpha1.TopicSchemaDefinition) field.ErrorList {
+var allErrs field.ErrorList
+
+if def.Schema == "" {
+allErrs = append(allErrs, field.Required(path.Child("schema"), "schema content must not be empty"))
+}
+
+// AVRO and JSON schemas must be valid JSON
+if (def.SchemaType == registryv1alpha1.SchemaTypeAvro || def.SchemaType == registryv1alpha1.SchemaTypeJSON) &&
+def.Schema != "" {
+if !json.Valid([]byte(def.Schema)) {
+allErrs = append(allErrs, field.Invalid(
+path.Child("schema"),
+def.Schema,
+fmt.Sprintf("%s schema must be valid JSON", def.SchemaType),
+))
+}
+}
+
+for i, ref := range def.References {
+refPath := path.Child("references").Index(i)
+if ref.Name == "" {
+allErrs = append(allErrs, field.Required(refPath.Child("name"), "reference name must not be empty"))
+}
+if ref.Subject == "" {
+allErrs = append(allErrs, field.Required(refPath.Child("subject"), "reference subject must not be empty"))
+}
+if ref.Version < 1 {
+allErrs = append(allErrs, field.Invalid(refPath.Child("version"), ref.Version, "reference version must be >= 1"))
+}
+}
+
+return allErrs
+}
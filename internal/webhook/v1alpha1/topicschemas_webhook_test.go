@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+. "github.com/onsi/ginkgo/v2"
+. "github.com/onsi/gomega"
+
+metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+registryv1alpha1 "github.com/honza/schema-strimzi-operator/api/v1alpha1"
+)
+
+func validTopicSchemas() *registryv1alpha1.TopicSchemas {
+return &registryv1alpha1.TopicSchemas{
+ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default"},
+Spec: registryv1alpha1.TopicSchemasSpec{
+Topic: "orders",
+Key: &registryv1alpha1.TopicSchemaDefinition{
+SchemaType: registryv1alpha1.SchemaTypeAvro,
+Schema:     `"string"`,
+},
+Value: &registryv1alpha1.TopicSchemaDefinition{
+SchemaType: registryv1alpha1.SchemaTypeAvro,
+Schema:     validAvroSchema,
+},
+RegistryRef: registryv1alpha1.SchemaRegistryRef{
+Name: "my-registry",
+},
+},
+}
+}
+
+var _ = Describe("TopicSchemas Webhook", func() {
+var validator TopicSchemasCustomValidator
+
+BeforeEach(func() {
+validator = TopicSchemasCustomValidator{}
+})
+
+Context("ValidateCreate", func() {
+It("Should accept valid TopicSchemas with key and value", func() {
+_, err := validator.ValidateCreate(ctx, validTopicSchemas())
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should accept TopicSchemas with only a value schema", func() {
+obj := validTopicSchemas()
+obj.Spec.Key = nil
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).NotTo(HaveOccurred())
+})
+
+It("Should reject when topic is empty", func() {
+obj := validTopicSchemas()
+obj.Spec.Topic = ""
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("topic"))
+})
+
+It("Should reject when neither key nor value is set", func() {
+obj := validTopicSchemas()
+obj.Spec.Key = nil
+obj.Spec.Value = nil
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("key or value"))
+})
+
+It("Should reject an invalid AVRO value schema", func() {
+obj := validTopicSchemas()
+obj.Spec.Value.Schema = `{not json}`
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("spec.value.schema"))
+})
+
+It("Should reject a key reference with invalid version", func() {
+obj := validTopicSchemas()
+obj.Spec.Key.References = []registryv1alpha1.SchemaReference{
+{Name: "Id", Subject: "ids-value", Version: 0},
+}
+_, err := validator.ValidateCreate(ctx, obj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("spec.key.references[0].version"))
+})
+})
+
+Context("ValidateUpdate", func() {
+It("Should reject changing the topic", func() {
+oldObj := validTopicSchemas()
+newObj := validTopicSchemas()
+newObj.Spec.Topic = "payments"
+_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("immutable"))
+})
+
+It("Should reject changing the value schemaType", func() {
+oldObj := validTopicSchemas()
+newObj := validTopicSchemas()
+newObj.Spec.Value.SchemaType = registryv1alpha1.SchemaTypeJSON
+_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+Expect(err).To(HaveOccurred())
+Expect(err.Error()).To(ContainSubstring("schemaType"))
+})
+
+It("Should accept adding a key schema", func() {
+oldObj := validTopicSchemas()
+oldObj.Spec.Key = nil
+newObj := validTopicSchemas()
+_, err := validator.ValidateUpdate(ctx, oldObj, newObj)
+Expect(err).NotTo(HaveOccurred())
+})
+})
+
+Context("ValidateDelete", func() {
+It("Should always allow deletion", func() {
+_, err := validator.ValidateDelete(ctx, validTopicSchemas())
+Expect(err).NotTo(HaveOccurred())
+})
+})
+})